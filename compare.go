@@ -0,0 +1,126 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/table"
+	"github.com/dr8co/palettes/palette"
+	"github.com/dr8co/palettes/registry"
+)
+
+// Thresholds of the CIEDE2000 differences highlighted in the comparison.
+const (
+	// deltaEClose is the difference below which colors are hard to tell apart.
+	deltaEClose = 2
+
+	// deltaEFar is the difference above which colors are more different than similar.
+	deltaEFar = 10
+)
+
+// handleCompareCommand processes the '-compare' flag to display palettes side by side.
+// The palettes are given as a comma-separated list of names, the first one being the reference.
+func handleCompareCommand(w io.Writer, reg *registry.SchemeRegistry, names, matchName string) error {
+	mode, err := palette.ParseMatchMode(matchName)
+	if err != nil {
+		return err
+	}
+
+	var palettes []*palette.Palette
+	for _, name := range strings.Split(names, ",") {
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+		p, err := findPalette(reg, name)
+		if err != nil {
+			return err
+		}
+		palettes = append(palettes, p)
+	}
+	if len(palettes) < 2 {
+		return errors.New("comparing palettes requires at least two comma-separated palette names")
+	}
+
+	renderComparison(w, mode, palettes)
+	return nil
+}
+
+// renderComparison prints palettes in aligned columns, pairing their colors with those of
+// the first palette, with the difference (ΔE) of each pair.
+func renderComparison(w io.Writer, mode palette.MatchMode, palettes []*palette.Palette) {
+	names := make([]string, 0, len(palettes))
+	for _, p := range palettes {
+		names = append(names, lipgloss.NewStyle().Bold(true).Render(p.Name()))
+	}
+	_, _ = fmt.Fprintf(w, "Compare: %s (by %s)\n", strings.Join(names, " vs "), mode)
+
+	label := "Role"
+	if mode == palette.MatchNearest {
+		label = "Color"
+	}
+	headers := []string{label, palettes[0].Name()}
+	for _, p := range palettes[1:] {
+		headers = append(headers, p.Name(), "ΔE")
+	}
+
+	t := table.New().
+		Border(lipgloss.RoundedBorder()).
+		Headers(headers...).
+		StyleFunc(func(row, _ int) lipgloss.Style {
+			if row == table.HeaderRow {
+				return lipgloss.NewStyle().Bold(true).Padding(0, 1)
+			}
+			return lipgloss.NewStyle().Padding(0, 1)
+		})
+
+	matches := palette.Compare(mode, palettes[0], palettes[1:]...)
+	totals := make([]float64, len(palettes))
+	counts := make([]int, len(palettes))
+	for _, match := range matches {
+		row := []string{match.Label, swatchCell(match, 0)}
+		for i := 1; i < len(palettes); i++ {
+			if !match.Found[i] || !match.Found[0] {
+				row = append(row, swatchCell(match, i), "")
+				continue
+			}
+			totals[i] += match.DeltaE[i]
+			counts[i]++
+			row = append(row, swatchCell(match, i), deltaECell(match.DeltaE[i]))
+		}
+		t.Row(row...)
+	}
+
+	_, _ = fmt.Fprintln(w, t.Render())
+	for i := 1; i < len(palettes); i++ {
+		if counts[i] > 0 {
+			_, _ = fmt.Fprintf(w, "Mean ΔE of %s: %.2f\n", palettes[i].Name(), totals[i]/float64(counts[i]))
+		}
+	}
+	_, _ = fmt.Fprintln(w)
+}
+
+// swatchCell renders the color of the i-th palette of a comparison row: a swatch, its name and hex value.
+func swatchCell(match palette.ColorMatch, i int) string {
+	if !match.Found[i] {
+		return "-"
+	}
+	color := match.Colors[i]
+	swatch := lipgloss.NewStyle().Background(color.Value).Render("    ")
+	return fmt.Sprintf("%s %s %s", swatch, color.Def.Hex, color.Def.Name)
+}
+
+// deltaECell renders a difference of a comparison row, faint when the colors are close
+// and bold when they are far apart.
+func deltaECell(delta float64) string {
+	style := lipgloss.NewStyle()
+	switch {
+	case delta < deltaEClose:
+		style = style.Faint(true)
+	case delta > deltaEFar:
+		style = style.Bold(true)
+	}
+	return style.Render(fmt.Sprintf("%6.2f", delta))
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/table"
+	"github.com/dr8co/palettes/palette"
+	"github.com/dr8co/palettes/registry"
+)
+
+// badgeStyles maps each WCAG level to the style of its pass/fail badge.
+var badgeStyles = map[palette.ContrastLevel]lipgloss.Style{
+	palette.LevelAAA:     badgeStyle("#1b5e20", "#ffffff"),
+	palette.LevelAA:      badgeStyle("#388e3c", "#ffffff"),
+	palette.LevelAALarge: badgeStyle("#f9a825", "#000000"),
+	palette.LevelFail:    badgeStyle("#c62828", "#ffffff"),
+}
+
+// badgeStyle creates a bold badge style with the given background and foreground colors.
+func badgeStyle(bg, fg string) lipgloss.Style {
+	return lipgloss.NewStyle().
+		Bold(true).
+		Padding(0, 1).
+		Background(lipgloss.Color(bg)).
+		Foreground(lipgloss.Color(fg))
+}
+
+// handleContrastCommand processes the '-contrast' flag to display the contrast matrix of a palette.
+func handleContrastCommand(w io.Writer, reg *registry.SchemeRegistry, query string) error {
+	p, err := findPalette(reg, query)
+	if err != nil {
+		return err
+	}
+
+	renderContrastMatrix(w, p, backgroundColors(p))
+	return nil
+}
+
+// backgroundRoles holds the roles of the colors used as text backgrounds, in display order.
+var backgroundRoles = []palette.Role{palette.RoleBackground, palette.RoleSelection}
+
+// backgroundColors returns the colors of a palette that are suitable as backgrounds:
+// the color of the background role (explicit or inferred), followed by the colors
+// explicitly assigned to the other background roles, without duplicates.
+func backgroundColors(p *palette.Palette) []palette.Color {
+	var backgrounds []palette.Color
+	for _, role := range backgroundRoles {
+		if role != palette.RoleBackground && !p.HasRole(role) {
+			continue
+		}
+		color, ok := p.Role(role)
+		if !ok || slices.ContainsFunc(backgrounds, func(bg palette.Color) bool { return bg.Value == color.Value }) {
+			continue
+		}
+		backgrounds = append(backgrounds, color)
+	}
+	return backgrounds
+}
+
+// renderContrastMatrix prints every color of a palette against each background color,
+// with the WCAG 2.x ratio and level badge and the APCA lightness contrast.
+func renderContrastMatrix(w io.Writer, p *palette.Palette, backgrounds []palette.Color) {
+	title := lipgloss.NewStyle().Bold(true).Render(p.Name())
+	_, _ = fmt.Fprintln(w, "Contrast:", title)
+
+	headers := make([]string, 0, len(backgrounds)+1)
+	headers = append(headers, "Color")
+	for _, bg := range backgrounds {
+		headers = append(headers, "on "+bg.Def.String())
+	}
+
+	t := table.New().
+		Border(lipgloss.RoundedBorder()).
+		Headers(headers...).
+		StyleFunc(func(row, _ int) lipgloss.Style {
+			if row == table.HeaderRow {
+				return lipgloss.NewStyle().Bold(true).Padding(0, 1)
+			}
+			return lipgloss.NewStyle().Padding(0, 1)
+		})
+
+	for _, fg := range p.Colors() {
+		row := make([]string, 0, len(backgrounds)+1)
+		row = append(row, fg.Style.Render(fg.Def.String()))
+
+		for _, bg := range backgrounds {
+			row = append(row, contrastCell(fg, bg))
+		}
+		t.Row(row...)
+	}
+
+	_, _ = fmt.Fprintln(w, t.Render())
+	_, _ = fmt.Fprintln(w)
+}
+
+// contrastCell renders a single cell of the contrast matrix.
+func contrastCell(fg, bg palette.Color) string {
+	ratio := palette.ContrastRatio(fg.Value, bg.Value)
+	level := palette.WCAGLevel(ratio)
+	apca := palette.APCAContrast(fg.Value, bg.Value)
+
+	sample := fg.Style.Background(bg.Value).Padding(0, 1).Render("Aa")
+	badge := badgeStyles[level].Render(fmt.Sprintf("%-4s", level))
+
+	return fmt.Sprintf("%s %5.2f:1 %s Lc %6.1f", sample, ratio, badge, apca)
+}
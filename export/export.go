@@ -0,0 +1,154 @@
+// Package export converts color palettes to machine-readable data formats
+// and to terminal emulator themes.
+//
+// Every exporter works on a [palette.Palette] and writes to an [io.Writer]. The data
+// formats (JSON, YAML and TOML) contain the palette name, its families and its ordered
+// color definitions. The terminal formats (Alacritty, Kitty, WezTerm, Ghostty and foot)
+// contain a ready-to-include theme built from the palette's [Theme]. The supported
+// formats are listed by [Formats].
+//
+// [WriteGo] generates Go source code declaring the colors of one or more palettes as
+// lipgloss colors, for applications that want a theme without depending on this module.
+// [WriteOSC] writes the escape sequences applying a palette's [Theme] to the running terminal,
+// and [WriteOSCReset] those restoring its default colors.
+//
+// Example usage:
+//
+//	format, err := export.ParseFormat("yaml")
+//	if err != nil {
+//		return err
+//	}
+//	err = export.Write(os.Stdout, palette.CreateDraculaPalette(), format)
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dr8co/palettes/palette"
+	"github.com/pelletier/go-toml/v2"
+	"go.yaml.in/yaml/v3"
+)
+
+// Format identifies an export format.
+type Format string
+
+// Supported export formats.
+const (
+	// FormatJSON exports the palette as an indented JSON document.
+	FormatJSON Format = "json"
+
+	// FormatYAML exports the palette as a YAML document.
+	FormatYAML Format = "yaml"
+
+	// FormatTOML exports the palette as a TOML document.
+	FormatTOML Format = "toml"
+)
+
+// encoder writes a palette to a writer in a specific format.
+type encoder func(w io.Writer, p *palette.Palette) error
+
+// encoders maps each supported format to its encoder.
+var encoders = map[Format]encoder{
+	FormatJSON: encodeJSON,
+	FormatYAML: encodeYAML,
+	FormatTOML: encodeTOML,
+
+	FormatAlacritty: terminalEncoder(alacrittyTemplate),
+	FormatKitty:     terminalEncoder(kittyTemplate),
+	FormatWezTerm:   terminalEncoder(weztermTemplate),
+	FormatGhostty:   terminalEncoder(ghosttyTemplate),
+	FormatFoot:      terminalEncoder(footTemplate),
+}
+
+// formatAliases maps alternative format names to their canonical format.
+var formatAliases = map[string]Format{
+	"yml": FormatYAML,
+}
+
+// Formats returns all supported export formats, in display order.
+func Formats() []Format {
+	return []Format{
+		FormatJSON, FormatYAML, FormatTOML,
+		FormatAlacritty, FormatKitty, FormatWezTerm, FormatGhostty, FormatFoot,
+	}
+}
+
+// extensions maps each supported format to the file name extension of its output.
+var extensions = map[Format]string{
+	FormatJSON:      ".json",
+	FormatYAML:      ".yaml",
+	FormatTOML:      ".toml",
+	FormatAlacritty: ".toml",
+	FormatKitty:     ".conf",
+	FormatWezTerm:   ".toml",
+	FormatGhostty:   "",
+	FormatFoot:      ".ini",
+}
+
+// Extension returns the conventional file name extension of the format, including the dot.
+// Ghostty themes have no extension.
+func (f Format) Extension() string {
+	return extensions[f]
+}
+
+// ParseFormat converts a format name (case-insensitive) to a [Format].
+func ParseFormat(name string) (Format, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if format, ok := formatAliases[name]; ok {
+		return format, nil
+	}
+
+	format := Format(name)
+	if _, ok := encoders[format]; !ok {
+		names := make([]string, 0, len(encoders))
+		for _, f := range Formats() {
+			names = append(names, string(f))
+		}
+		return "", fmt.Errorf("unsupported export format '%s' (supported: %s)", name, strings.Join(names, ", "))
+	}
+	return format, nil
+}
+
+// Write exports a palette to w in the given format.
+func Write(w io.Writer, p *palette.Palette, format Format) error {
+	encode, ok := encoders[format]
+	if !ok {
+		return fmt.Errorf("unsupported export format '%s'", format)
+	}
+	return encode(w, p)
+}
+
+// encodeJSON writes the palette document as indented JSON.
+func encodeJSON(w io.Writer, p *palette.Palette) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(p.Document()); err != nil {
+		return fmt.Errorf("encoding %s as JSON: %w", p.Name(), err)
+	}
+	return nil
+}
+
+// encodeYAML writes the palette document as YAML.
+func encodeYAML(w io.Writer, p *palette.Palette) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(p.Document()); err != nil {
+		return fmt.Errorf("encoding %s as YAML: %w", p.Name(), err)
+	}
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("encoding %s as YAML: %w", p.Name(), err)
+	}
+	return nil
+}
+
+// encodeTOML writes the palette document as TOML.
+func encodeTOML(w io.Writer, p *palette.Palette) error {
+	if err := toml.NewEncoder(w).Encode(p.Document()); err != nil {
+		return fmt.Errorf("encoding %s as TOML: %w", p.Name(), err)
+	}
+	return nil
+}
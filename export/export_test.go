@@ -0,0 +1,80 @@
+package export_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dr8co/palettes/export"
+	"github.com/dr8co/palettes/palette"
+)
+
+// TestDataRoundTrip exports a palette in every data format and loads it back.
+func TestDataRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	p := palette.NewPalette("Acme \"Brand\" Ünicode", "dark", "custom").
+		AddColor("background", "#101820").
+		AddColor("foreground", "#f2f2f2").
+		AddColor("accent #1", "#fee715cc").
+		AddColor("error", "#f00").
+		SetRole(palette.RoleAccent, "accent #1").
+		SetRole(palette.RoleSelection, "#ff0000").
+		SetMetadata(palette.Metadata{
+			Author:      "Jane O'Doe",
+			URL:         "https://example.com/acme",
+			License:     "CC-BY-4.0",
+			Description: "Line one\nline two: with # and 'quotes'",
+			Version:     "2.1",
+		})
+	if err := p.Err(); err != nil {
+		t.Fatalf("test palette: %v", err)
+	}
+
+	for _, format := range []export.Format{export.FormatJSON, export.FormatYAML, export.FormatTOML} {
+		t.Run(string(format), func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			if err := export.Write(&buf, p, format); err != nil {
+				t.Fatalf("Write: %v", err)
+			}
+
+			path := filepath.Join(t.TempDir(), "acme"+format.Extension())
+			if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			loaded, err := palette.LoadFile(path)
+			if err != nil {
+				t.Fatalf("LoadFile: %v\n%s", err, buf.String())
+			}
+
+			if got, want := loaded.Document(), p.Document(); !reflect.DeepEqual(got, want) {
+				t.Errorf("round trip through %s = %+v, want %+v\n%s", format, got, want, buf.String())
+			}
+		})
+	}
+}
+
+// TestTOMLMetadata checks that the metadata are inlined at the top level of TOML documents.
+func TestTOMLMetadata(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	if err := export.Write(&buf, palette.CreateDraculaPalette(), export.FormatTOML); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	header, _, _ := strings.Cut(buf.String(), "[[colors]]")
+	for _, want := range []string{"name = 'Dracula'\n", "author = 'Zeno Rocha'\n", "url = 'https://draculatheme.com'\n", "license = 'MIT'\n"} {
+		if !strings.Contains(header, want) {
+			t.Errorf("TOML document does not start with %q:\n%s", want, header)
+		}
+	}
+	if strings.Contains(buf.String(), "[Metadata]") {
+		t.Errorf("TOML document has a Metadata table:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,260 @@
+package export
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/dr8co/palettes/palette"
+	"golang.org/x/text/unicode/norm"
+)
+
+// DefaultGoPackage is the package name of the generated Go code when none is given.
+const DefaultGoPackage = "theme"
+
+// GoOptions configures the Go code generated by [WriteGo].
+type GoOptions struct {
+	// Package is the name of the generated package. It defaults to [DefaultGoPackage].
+	Package string
+}
+
+// goTheme is the data of a generated theme variable.
+type goTheme struct {
+	Var      string
+	Name     string
+	Metadata palette.Metadata
+	Colors   map[string]string // color hex values, by field name
+	Roles    map[string]string // role hex values, by field name
+}
+
+// goRoleNames maps the abbreviated role names to the field names of the generated Roles struct.
+var goRoleNames = map[palette.Role]string{
+	palette.RoleBackground: "Background",
+	palette.RoleForeground: "Foreground",
+	palette.RoleWarning:    "Warning",
+}
+
+// goRoleFields holds the fields of the generated Roles struct, in canonical role order.
+var goRoleFields = func() []goField {
+	fields := make([]goField, 0, len(palette.Roles()))
+	for _, role := range palette.Roles() {
+		fields = append(fields, goField{Name: goRoleField(role), Comment: string(role)})
+	}
+	return fields
+}()
+
+// goRoleField returns the field name of a role in the generated Roles struct.
+func goRoleField(role palette.Role) string {
+	if name, ok := goRoleNames[role]; ok {
+		return name
+	}
+	return goIdentifier(string(role))
+}
+
+// goField is a field of a generated struct.
+type goField struct {
+	Name    string
+	Comment string
+}
+
+var goTemplate = template.Must(template.New("go").Funcs(template.FuncMap{
+	"quote":   strconv.Quote,
+	"comment": singleLine,
+}).Parse(`// Code generated by palettes (https://github.com/dr8co/palettes). DO NOT EDIT.
+
+// Package {{.Package}} provides lipgloss colors for the {{range $i, $t := .Themes}}{{if $i}}, {{end}}{{comment $t.Name}}{{end}} palette{{if gt (len .Themes) 1}}s{{end}}.
+package {{.Package}}
+
+import (
+	"image/color"
+
+	"charm.land/lipgloss/v2"
+)
+
+// Theme holds the colors of a palette, by name. Colors missing from a palette are [lipgloss.NoColor].
+type Theme struct {
+	// Name is the name of the palette.
+	Name string
+
+	// Roles holds the colors of the semantic roles.
+	Roles Roles
+{{range .Fields}}
+	{{.Name}} color.Color // {{comment .Comment}}
+{{- end}}
+}
+
+// Roles holds the colors of the semantic roles of a palette.
+type Roles struct {
+{{- range .RoleFields}}
+	{{.Name}} color.Color // {{comment .Comment}}
+{{- end}}
+}
+{{range .Themes}}
+{{- $theme := .}}
+// {{.Var}} is the {{comment .Name}} palette.
+{{- with .Metadata.Description}}
+// {{comment .}}{{end}}
+{{- if or .Metadata.Author .Metadata.URL .Metadata.License}}
+//
+{{- with .Metadata.Author}}
+// Author: {{comment .}}{{end}}
+{{- with .Metadata.URL}}
+// Upstream: {{comment .}}{{end}}
+{{- with .Metadata.License}}
+// License: {{comment .}}{{end}}
+{{- with .Metadata.Version}}
+// Version: {{comment .}}{{end}}
+{{- end}}
+var {{.Var}} = Theme{
+	Name: {{quote .Name}},
+	Roles: Roles{
+{{- range $.RoleFields}}
+		{{.Name}}: lipgloss.Color({{quote (index $theme.Roles .Name)}}),
+{{- end}}
+	},
+{{- range $.Fields}}
+{{- $field := .Name}}
+{{- with index $theme.Colors $field}}
+	{{$field}}: lipgloss.Color({{quote .}}),
+{{- else}}
+	{{$field}}: lipgloss.NoColor{},
+{{- end}}
+{{- end}}
+}
+{{end}}
+// Themes holds all the themes, by palette name.
+var Themes = map[string]Theme{
+{{- range .Themes}}
+	{{quote .Name}}: {{.Var}},
+{{- end}}
+}
+`))
+
+// WriteGo writes a Go source file declaring a Theme struct of lipgloss colors and one variable
+// per palette, so that applications can use the palettes without depending on this module.
+//
+// The struct has a field per color name (the union of the color names of all the palettes)
+// and the colors of the semantic roles. The generated code is formatted with gofmt.
+func WriteGo(w io.Writer, palettes []*palette.Palette, opts GoOptions) error {
+	if len(palettes) == 0 {
+		return errors.New("no palette to generate Go code for")
+	}
+
+	pkg := opts.Package
+	if pkg == "" {
+		pkg = DefaultGoPackage
+	}
+	if !token.IsIdentifier(pkg) || token.IsKeyword(pkg) {
+		return fmt.Errorf("invalid Go package name '%s'", pkg)
+	}
+
+	var fields []goField
+	known := make(map[string]bool)
+	themes := make([]goTheme, 0, len(palettes))
+	// Theme variables must not collide with the generated types and map
+	vars := map[string]bool{"Theme": true, "Roles": true, "Themes": true}
+
+	for _, p := range palettes {
+		theme := goTheme{
+			Var:      uniqueIdentifier(goIdentifier(p.Name()), vars),
+			Name:     p.Name(),
+			Metadata: p.Metadata(),
+			Colors:   make(map[string]string),
+			Roles:    make(map[string]string),
+		}
+
+		// Colors sharing a name within a palette are numbered: Red, Red2...
+		seen := make(map[string]bool)
+		for _, color := range p.Colors() {
+			name := uniqueIdentifier(goIdentifier(color.Def.Name), seen)
+			theme.Colors[name] = color.Value.Hex()
+			if !known[name] {
+				known[name] = true
+				fields = append(fields, goField{Name: name, Comment: color.Def.Name})
+			}
+		}
+
+		for role, color := range p.ResolvedRoles() {
+			theme.Roles[goRoleField(role)] = color.Value.Hex()
+		}
+		themes = append(themes, theme)
+	}
+
+	for _, field := range fields {
+		if field.Name == "Name" || field.Name == "Roles" {
+			return fmt.Errorf("color name '%s' conflicts with a field of the generated Theme", field.Comment)
+		}
+	}
+
+	var buf bytes.Buffer
+	err := goTemplate.Execute(&buf, map[string]any{
+		"Package":    pkg,
+		"Fields":     fields,
+		"RoleFields": goRoleFields,
+		"Themes":     themes,
+	})
+	if err != nil {
+		return fmt.Errorf("generating Go code: %w", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting generated Go code: %w", err)
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// goIdentifier converts a name to an exported Go identifier in CamelCase, without accents:
+// "Highlight Med" becomes HighlightMed, "bg0_h" becomes Bg0H and "Rosé Pine" becomes RosePine.
+// Identifiers that would not be exported ("", "0", "日本") are prefixed with "Color".
+func goIdentifier(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range norm.NFD.String(name) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// Drop the accents of decomposed characters
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if upper {
+				r = unicode.ToUpper(r)
+			}
+			b.WriteRune(r)
+			upper = false
+		default:
+			upper = true
+		}
+	}
+
+	id := norm.NFC.String(b.String())
+	if !token.IsExported(id) {
+		id = "Color" + id
+	}
+	return id
+}
+
+// singleLine replaces the line breaks and other control characters of s with spaces, so that
+// names and metadata from palette files cannot end a comment of a generated file and inject code.
+func singleLine(s string) string {
+	return strings.Join(strings.FieldsFunc(s, func(r rune) bool {
+		return unicode.IsControl(r) || unicode.In(r, unicode.Zl, unicode.Zp)
+	}), " ")
+}
+
+// uniqueIdentifier returns id, or id followed by the lowest number from 2 that is not
+// in used yet, and marks the returned identifier as used.
+func uniqueIdentifier(id string, used map[string]bool) string {
+	unique := id
+	for n := 2; used[unique]; n++ {
+		unique = id + strconv.Itoa(n)
+	}
+	used[unique] = true
+	return unique
+}
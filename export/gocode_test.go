@@ -0,0 +1,68 @@
+package export_test
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/dr8co/palettes/export"
+	"github.com/dr8co/palettes/palette"
+)
+
+// TestWriteGoHostileMetadata checks that names and metadata with line breaks cannot end the
+// comments of the generated code and inject declarations.
+func TestWriteGoHostileMetadata(t *testing.T) {
+	t.Parallel()
+
+	const payload = "\nfunc init() { panic(\"pwned\") }\n//"
+	p := palette.NewPalette("Evil"+payload, "dark").
+		AddColor("red"+payload, "#ff0000").
+		AddColor("black", "#000000").
+		SetMetadata(palette.Metadata{
+			Description: "A palette" + payload + "\r\nvar _ = 1\u2028var _ = 2",
+			Author:      "Mallory" + payload,
+			URL:         "https://example.com" + payload,
+			License:     "MIT" + payload,
+			Version:     "1.0" + payload,
+		})
+
+	var buf bytes.Buffer
+	if err := export.WriteGo(&buf, []*palette.Palette{p}, export.GoOptions{}); err != nil {
+		t.Fatalf("WriteGo: %v", err)
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), "theme.go", buf.Bytes(), parser.ParseComments)
+	if err != nil {
+		t.Fatalf("parsing generated code: %v\n%s", err, buf.String())
+	}
+
+	// The import, the Theme and Roles types, the palette variable and the Themes map
+	var decls []string
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			t.Errorf("generated code declares func %s:\n%s", decl.Name.Name, buf.String())
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					decls = append(decls, spec.Name.Name)
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						decls = append(decls, name.Name)
+					}
+				}
+			}
+		}
+	}
+	if got, want := strings.Join(decls, " "), "Theme Roles EvilFuncInitPanicPwned Themes"; got != want {
+		t.Errorf("generated declarations = %s, want %s\n%s", got, want, buf.String())
+	}
+
+	if want := "// A palette func init() { panic(\"pwned\") } // var _ = 1 var _ = 2\n"; !strings.Contains(buf.String(), want) {
+		t.Errorf("generated code does not contain the description on a single line %q:\n%s", want, buf.String())
+	}
+}
@@ -0,0 +1,72 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/dr8co/palettes/palette"
+)
+
+// OSCOptions configures the escape sequences written by [WriteOSC] and [WriteOSCReset].
+type OSCOptions struct {
+	// Tmux wraps every sequence in a tmux passthrough sequence, so that it reaches the terminal
+	// running tmux instead of being interpreted by tmux. It requires the tmux option
+	// allow-passthrough to be on.
+	Tmux bool
+}
+
+// WriteOSC writes the OSC escape sequences that apply the [Theme] of a palette to the running terminal:
+// its 16 ANSI colors (OSC 4), foreground (OSC 10), background (OSC 11) and cursor (OSC 12) colors.
+// The changes last until the terminal is reset (see [WriteOSCReset]) or closed.
+func WriteOSC(w io.Writer, p *palette.Palette, opts OSCOptions) error {
+	t, err := NewTheme(p)
+	if err != nil {
+		return err
+	}
+
+	seqs := make([]string, 0, len(t.ANSI)+3)
+	for i, c := range t.ANSI {
+		seqs = append(seqs, fmt.Sprintf("\x1b]4;%d;%s\x07", i, xColor(c)))
+	}
+	seqs = append(seqs,
+		ansi.SetForegroundColor(xColor(t.Foreground)),
+		ansi.SetBackgroundColor(xColor(t.Background)),
+		ansi.SetCursorColor(xColor(t.Cursor)),
+	)
+
+	if err := writeSequences(w, seqs, opts); err != nil {
+		return fmt.Errorf("applying palette %s: %w", p.Name(), err)
+	}
+	return nil
+}
+
+// WriteOSCReset writes the OSC escape sequences that restore the default colors of the running
+// terminal, undoing [WriteOSC]: the ANSI colors (OSC 104), foreground (OSC 110), background (OSC 111)
+// and cursor (OSC 112) colors.
+func WriteOSCReset(w io.Writer, opts OSCOptions) error {
+	seqs := []string{"\x1b]104\x07", ansi.ResetForegroundColor, ansi.ResetBackgroundColor, ansi.ResetCursorColor}
+	if err := writeSequences(w, seqs, opts); err != nil {
+		return fmt.Errorf("resetting terminal colors: %w", err)
+	}
+	return nil
+}
+
+// writeSequences writes escape sequences to w, wrapped for tmux if requested.
+func writeSequences(w io.Writer, seqs []string, opts OSCOptions) error {
+	var b strings.Builder
+	for _, seq := range seqs {
+		if opts.Tmux {
+			seq = ansi.TmuxPassthrough(seq)
+		}
+		b.WriteString(seq)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// xColor formats a color in the XParseColor syntax (rgb:rr/gg/bb), the most widely supported by terminals.
+func xColor(c palette.RGBA) string {
+	return fmt.Sprintf("rgb:%02x/%02x/%02x", c.R, c.G, c.B)
+}
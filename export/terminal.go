@@ -0,0 +1,214 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/dr8co/palettes/palette"
+)
+
+// Terminal emulator export formats.
+const (
+	// FormatAlacritty exports an Alacritty TOML color configuration.
+	FormatAlacritty Format = "alacritty"
+
+	// FormatKitty exports a Kitty .conf theme.
+	FormatKitty Format = "kitty"
+
+	// FormatWezTerm exports a WezTerm TOML color scheme.
+	FormatWezTerm Format = "wezterm"
+
+	// FormatGhostty exports a Ghostty theme.
+	FormatGhostty Format = "ghostty"
+
+	// FormatFoot exports a foot INI color configuration.
+	FormatFoot Format = "foot"
+)
+
+// templateFuncs are the helpers available to the terminal theme templates.
+var templateFuncs = template.FuncMap{
+	// bare formats a color as a hex string without the leading '#'
+	"bare": func(c palette.RGBA) string {
+		return strings.TrimPrefix(c.Hex(), "#")
+	},
+	// ansiName returns the name of an ANSI slot (0-7)
+	"ansiName": func(i int) string {
+		return ansiNames[i]
+	},
+	// normal returns the normal ANSI colors (0-7)
+	"normal": func(t Theme) []palette.RGBA {
+		return t.ANSI[:8]
+	},
+	// bright returns the bright ANSI colors (8-15)
+	"bright": func(t Theme) []palette.RGBA {
+		return t.ANSI[8:]
+	},
+	// header returns the comment lines naming the theme and attributing the palette
+	"header": header,
+	// toml quotes a string as a TOML basic string
+	"toml": tomlString,
+}
+
+// header returns the comment lines at the top of the terminal themes: the theme name,
+// the attribution details of the palette and a note about the generator.
+// Line breaks in the name and metadata are replaced with spaces, so that they stay in the comments.
+func header(t Theme) string {
+	lines := []string{"# " + singleLine(t.Name)}
+	meta := t.Metadata
+	if meta.Description != "" {
+		lines = append(lines, "# "+singleLine(meta.Description))
+	}
+	for _, field := range [...]struct{ label, value string }{
+		{"Author", meta.Author},
+		{"Upstream", meta.URL},
+		{"License", meta.License},
+		{"Version", meta.Version},
+	} {
+		if field.value != "" {
+			lines = append(lines, fmt.Sprintf("# %-9s %s", field.label+":", singleLine(field.value)))
+		}
+	}
+	lines = append(lines, "# Generated by palettes (https://github.com/dr8co/palettes)")
+	return strings.Join(lines, "\n")
+}
+
+// tomlString quotes s as a TOML basic string, escaping quotes, backslashes and control characters.
+func tomlString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"', '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\r':
+			b.WriteString(`\r`)
+		default:
+			if unicode.IsControl(r) {
+				fmt.Fprintf(&b, `\u%04X`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+var alacrittyTemplate = template.Must(template.New("alacritty").Funcs(templateFuncs).Parse(
+	`{{header .}}
+
+[colors.primary]
+background = "{{.Background}}"
+foreground = "{{.Foreground}}"
+
+[colors.cursor]
+text = "{{.CursorText}}"
+cursor = "{{.Cursor}}"
+
+[colors.selection]
+text = "{{.SelectionForeground}}"
+background = "{{.SelectionBackground}}"
+
+[colors.normal]
+{{- range $i, $c := normal .}}
+{{ansiName $i}} = "{{$c}}"
+{{- end}}
+
+[colors.bright]
+{{- range $i, $c := bright .}}
+{{ansiName $i}} = "{{$c}}"
+{{- end}}
+`))
+
+var kittyTemplate = template.Must(template.New("kitty").Funcs(templateFuncs).Parse(
+	`{{header .}}
+
+foreground           {{.Foreground}}
+background           {{.Background}}
+selection_foreground {{.SelectionForeground}}
+selection_background {{.SelectionBackground}}
+cursor               {{.Cursor}}
+cursor_text_color    {{.CursorText}}
+{{range $i, $c := .ANSI}}
+color{{$i}} {{if lt $i 10}} {{end}}{{$c}}
+{{- end}}
+`))
+
+var weztermTemplate = template.Must(template.New("wezterm").Funcs(templateFuncs).Parse(
+	`{{header .}}
+
+[colors]
+background = "{{.Background}}"
+foreground = "{{.Foreground}}"
+cursor_bg = "{{.Cursor}}"
+cursor_fg = "{{.CursorText}}"
+cursor_border = "{{.Cursor}}"
+selection_bg = "{{.SelectionBackground}}"
+selection_fg = "{{.SelectionForeground}}"
+ansi = [{{range $i, $c := normal .}}{{if $i}}, {{end}}"{{$c}}"{{end}}]
+brights = [{{range $i, $c := bright .}}{{if $i}}, {{end}}"{{$c}}"{{end}}]
+
+[metadata]
+name = {{toml .Name}}
+{{- with .Metadata.Author}}
+author = {{toml .}}
+{{- end}}
+origin_url = {{with .Metadata.URL}}{{toml .}}{{else}}"https://github.com/dr8co/palettes"{{end}}
+`))
+
+var ghosttyTemplate = template.Must(template.New("ghostty").Funcs(templateFuncs).Parse(
+	`{{header .}}
+
+background = {{.Background}}
+foreground = {{.Foreground}}
+cursor-color = {{.Cursor}}
+cursor-text = {{.CursorText}}
+selection-background = {{.SelectionBackground}}
+selection-foreground = {{.SelectionForeground}}
+{{range $i, $c := .ANSI}}
+palette = {{$i}}={{$c}}
+{{- end}}
+`))
+
+var footTemplate = template.Must(template.New("foot").Funcs(templateFuncs).Parse(
+	`{{header .}}
+
+[colors]
+foreground={{bare .Foreground}}
+background={{bare .Background}}
+cursor={{bare .CursorText}} {{bare .Cursor}}
+selection-foreground={{bare .SelectionForeground}}
+selection-background={{bare .SelectionBackground}}
+{{range $i, $c := normal .}}
+regular{{$i}}={{bare $c}}
+{{- end}}
+{{range $i, $c := bright .}}
+bright{{$i}}={{bare $c}}
+{{- end}}
+`))
+
+// terminalEncoder creates an encoder rendering the terminal theme of a palette with a template.
+func terminalEncoder(tmpl *template.Template) encoder {
+	return func(w io.Writer, p *palette.Palette) error {
+		theme, err := NewTheme(p)
+		if err != nil {
+			return err
+		}
+		if err := tmpl.Execute(w, theme); err != nil {
+			return fmt.Errorf("rendering %s theme for %s: %w", tmpl.Name(), p.Name(), err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,118 @@
+package export_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/dr8co/palettes/export"
+	"github.com/dr8co/palettes/internal/golden"
+	"github.com/dr8co/palettes/palette"
+	"github.com/pelletier/go-toml/v2"
+)
+
+// hostilePalette returns a palette whose name and metadata contain line breaks
+// followed by configuration directives.
+func hostilePalette() *palette.Palette {
+	const payload = "\nshell rm -rf ~\r\ninclude /tmp/evil.conf"
+	return palette.NewPalette("Evil"+payload, "dark").
+		AddColor("background", "#000000").
+		AddColor("foreground", "#ffffff").
+		SetMetadata(palette.Metadata{
+			Description: "A palette" + payload,
+			Author:      "Mallory" + payload,
+			URL:         "https://example.com" + payload,
+			License:     "MIT" + payload,
+			Version:     "1.0" + payload,
+		})
+}
+
+// TestTerminalHeaderMetadata checks that line breaks in the name and metadata of a palette
+// stay in the comment lines of the terminal themes.
+func TestTerminalHeaderMetadata(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []export.Format{
+		export.FormatAlacritty, export.FormatKitty, export.FormatWezTerm, export.FormatGhostty, export.FormatFoot,
+	} {
+		t.Run(string(format), func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			if err := export.Write(&buf, hostilePalette(), format); err != nil {
+				t.Fatalf("Write: %v", err)
+			}
+
+			header, _, _ := strings.Cut(buf.String(), "\n\n")
+			lines := strings.Split(header, "\n")
+			for _, line := range lines {
+				if !strings.HasPrefix(line, "# ") || strings.ContainsRune(line, '\r') {
+					t.Errorf("header line %q is not a comment:\n%s", line, buf.String())
+				}
+			}
+			if len(lines) != 7 {
+				t.Errorf("header has %d lines, want 7:\n%s", len(lines), header)
+			}
+			if want := "# A palette shell rm -rf ~ include /tmp/evil.conf"; lines[1] != want {
+				t.Errorf("description line = %q, want %q", lines[1], want)
+			}
+		})
+	}
+}
+
+// TestTerminalGolden compares the terminal themes of a bundled palette with their golden files.
+func TestTerminalGolden(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []export.Format{
+		export.FormatAlacritty, export.FormatKitty, export.FormatWezTerm, export.FormatGhostty, export.FormatFoot,
+	} {
+		t.Run(string(format), func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			if err := export.Write(&buf, palette.CreateDraculaPalette(), format); err != nil {
+				t.Fatalf("Write: %v", err)
+			}
+			golden.Assert(t, "dracula-"+string(format), buf.Bytes())
+		})
+	}
+}
+
+// TestWezTermQuoting checks that the metadata of WezTerm themes are valid TOML strings.
+func TestWezTermQuoting(t *testing.T) {
+	t.Parallel()
+
+	p := palette.NewPalette(`Acme "Brand"`, "dark").
+		AddColor("background", "#000000").
+		AddColor("foreground", "#ffffff").
+		SetMetadata(palette.Metadata{
+			Author: "Mallory \\ Co\n[colors]\tbackground = \"#ff0000\"\x7f",
+			URL:    `https://example.com/"quoted"`,
+		})
+
+	var buf bytes.Buffer
+	if err := export.Write(&buf, p, export.FormatWezTerm); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	var theme struct {
+		Colors   struct{ Background string }
+		Metadata struct {
+			Name      string
+			Author    string
+			OriginURL string `toml:"origin_url"`
+		}
+	}
+	if err := toml.Unmarshal(buf.Bytes(), &theme); err != nil {
+		t.Fatalf("WezTerm theme is not valid TOML: %v\n%s", err, buf.String())
+	}
+
+	meta := p.Metadata()
+	if theme.Metadata.Name != p.Name() || theme.Metadata.Author != meta.Author || theme.Metadata.OriginURL != meta.URL {
+		t.Errorf("metadata = %+v, want name %q, author %q and origin_url %q", theme.Metadata, p.Name(), meta.Author, meta.URL)
+	}
+	if theme.Colors.Background != "#000000" {
+		t.Errorf("background = %q, want #000000", theme.Colors.Background)
+	}
+}
@@ -0,0 +1,53 @@
+package export
+
+import (
+	"errors"
+
+	"github.com/dr8co/palettes/palette"
+)
+
+// ANSI color slot names, in terminal order (0-7; 8-15 are their bright variants).
+var ansiNames = [8]string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+// Theme is the assignment of palette colors to the slots of a terminal emulator theme.
+type Theme struct {
+	// Name is the name of the palette the theme was derived from.
+	Name string
+
+	// Metadata holds the attribution details of the palette.
+	Metadata palette.Metadata
+
+	Background          palette.RGBA
+	Foreground          palette.RGBA
+	Cursor              palette.RGBA
+	CursorText          palette.RGBA
+	SelectionBackground palette.RGBA
+	SelectionForeground palette.RGBA
+
+	// ANSI holds the 16 ANSI colors: 0-7 are the normal colors, 8-15 the bright ones.
+	ANSI [16]palette.RGBA
+}
+
+// NewTheme derives a terminal theme from the semantic roles of a palette.
+// See [palette.Palette.ResolvedRoles] for how roles without an explicit assignment are inferred.
+func NewTheme(p *palette.Palette) (Theme, error) {
+	roles := p.ResolvedRoles()
+	if len(roles) == 0 {
+		return Theme{}, errors.New("palette " + p.Name() + " has no colors")
+	}
+
+	t := Theme{
+		Name:                p.Name(),
+		Metadata:            p.Metadata(),
+		Background:          roles[palette.RoleBackground].Value,
+		Foreground:          roles[palette.RoleForeground].Value,
+		Cursor:              roles[palette.RoleCursor].Value,
+		CursorText:          roles[palette.RoleBackground].Value,
+		SelectionBackground: roles[palette.RoleSelection].Value,
+		SelectionForeground: roles[palette.RoleForeground].Value,
+	}
+	for i, role := range palette.ANSIRoles {
+		t.ANSI[i] = roles[role].Value
+	}
+	return t, nil
+}
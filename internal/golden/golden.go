@@ -0,0 +1,46 @@
+// Package golden provides helpers for golden file (snapshot) tests.
+//
+// Golden files live in the testdata directory of the package under test and are
+// named after the snapshot, with a ".golden" extension. Run the tests with the
+// -update flag to (re)generate them:
+//
+//	go test ./palette -update
+package golden
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// Assert compares got with the contents of the golden file testdata/<name>.golden,
+// failing the test if they differ. With the -update flag, the golden file is
+// written instead.
+func Assert(t testing.TB, name string, got []byte) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			t.Fatalf("creating golden directory: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0o600); err != nil {
+			t.Fatalf("updating golden file: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path) //nolint:gosec // the path is built from test names
+	if err != nil {
+		t.Fatalf("reading golden file (run with -update to create it): %v", err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Errorf("output does not match %s (run with -update to accept the changes)\n--- got ---\n%s\n--- want ---\n%s",
+			path, got, want)
+	}
+}
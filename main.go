@@ -5,11 +5,17 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
 	"strings"
 
+	"github.com/charmbracelet/colorprofile"
+	"github.com/dr8co/palettes/export"
 	"github.com/dr8co/palettes/palette"
 	"github.com/dr8co/palettes/registry"
+	"github.com/dr8co/palettes/tui"
 )
 
 const version = "1.0.0"
@@ -20,7 +26,7 @@ func printUsage() {
 ==========================
 
 USAGE:
-    %s [OPTIONS]
+    %[1]s [OPTIONS]
 
 DESCRIPTION:
     Displays various popular color palettes on the terminal.
@@ -29,106 +35,386 @@ DESCRIPTION:
 OPTIONS:
     -s, -show string       Show specific palette or palette family (e.g., 'dark', 'mocha')
     -l, -list              List all available palettes
+    -browse                Browse the palettes interactively (filter, preview, copy colors,
+                           export with -format)
+    -long                  Include palette details (author, upstream URL, license...) in the list
+    -contrast string       Show the WCAG/APCA contrast matrix of a palette against its backgrounds
+    -compare string        Compare palettes side by side (comma-separated names, the first one
+                           being the reference), with the ΔE of each pair of colors
+    -match string          Pair the compared colors by semantic role or by nearest color:
+                           role or nearest (default "role")
+    -export string         Export a palette in a machine-readable format (see -format)
+    -format string         Export format: json, yaml, toml, alacritty, kitty, wezterm, ghostty
+                           or foot (default "json")
+    -filter string         Only include palettes matching a family query, combining families
+                           with & (and), | (or), ! (not) and parentheses
+    -gen-go string         Generate Go code declaring lipgloss colors for a palette, family or
+                           family query
+    -package string        Package name of the generated Go code (default "theme")
+    -apply string          Apply a palette to the running terminal (ANSI, foreground, background
+                           and cursor colors) with OSC escape sequences, through tmux if needed
+    -reset                 Restore the default colors of the running terminal after -apply
+    -layout string         Layout of the shown palettes: text, strip, grid, table or sample
+                           (default "text")
+    -profile string        Preview palettes as rendered with a color profile: truecolor, 256, 16
+                           or ascii, next to the nearest approximated colors
+    -import string         Import a base16/base24 scheme file (YAML) as a palette
+    -palette-dir string    Load additional palette files (JSON, YAML or TOML) from a directory
+                           (default "$XDG_CONFIG_HOME/palettes")
     -v, -version           Show version information
     -h, -help              Show this help message
 
 EXAMPLES:
-    %s                           # Show all palettes
-    %s -show catppuccin          # Show all Catppuccin variants
-    %s -list                     # List all available palettes
-    %s -s dark                   # Show all dark theme palettes (short form)
-    %s -show mocha               # Show Catppuccin Mocha variant
-    %s -show "Catppuccin Mocha"  # Show exact palette name
-    %s -l                        # List all palettes (short form)
-`, os.Args[0], os.Args[0], os.Args[0], os.Args[0], os.Args[0], os.Args[0], os.Args[0], os.Args[0])
+    %[1]s                           # Show all palettes
+    %[1]s -show catppuccin          # Show all Catppuccin variants
+    %[1]s -list                     # List all available palettes
+    %[1]s -s dark                   # Show all dark theme palettes (short form)
+    %[1]s -show mocha               # Show Catppuccin Mocha variant
+    %[1]s -show "Catppuccin Mocha"  # Show exact palette name
+    %[1]s -l                        # List all palettes (short form)
+    %[1]s -browse                   # Browse the palettes in a full-screen interface
+    %[1]s -list -long               # List all palettes with their author, URL and license
+    %[1]s -show "dark & pastel & !catppuccin"  # Show palettes matching a family query
+    %[1]s -filter "nord | gruvbox" -list     # List Nord and Gruvbox palettes
+    %[1]s -contrast mocha           # Show contrast ratios of Catppuccin Mocha colors
+    %[1]s -layout strip             # Show all palettes as compact swatch strips
+    %[1]s -show nord -layout table  # Show the Nord palettes with their hex, RGB and HSL values
+    %[1]s -show dracula -layout sample # Preview Dracula on code, a git diff and a shell session
+    %[1]s -show dracula -profile 256 # Preview Dracula on a 256-color terminal
+    %[1]s -compare macchiato,"tokyo night dark" # Compare two palettes by role
+    %[1]s -compare nord-frost,dracula -match nearest # Pair Nord Frost colors with the nearest Dracula ones
+    %[1]s -export dracula -format yaml # Export a palette as YAML
+    %[1]s -export mocha -format kitty  # Export a Kitty theme
+    %[1]s -gen-go mocha > theme.go  # Generate lipgloss colors for Catppuccin Mocha
+    %[1]s -gen-go catppuccin -package colors # Generate all Catppuccin variants
+    %[1]s -apply "tokyo night dark" # Try Tokyo Night on the current terminal
+    %[1]s -reset                    # Restore the terminal colors
+    %[1]s -palette-dir ./themes -list  # Include palettes defined in ./themes
+    %[1]s -import ocean.yaml           # Show a base16 scheme (combine with -export to convert it)
+`, os.Args[0])
+}
+
+// options holds the command-line options.
+type options struct {
+	show, contrast, compare, match string
+	export, format, genGo, pkg     string
+	apply, filter, layout, profile string
+	importFile, paletteDir         string
+
+	list, long, browse, reset, help, version bool
+
+	// layoutSet reports whether -layout was given explicitly
+	layoutSet bool
 }
 
 func main() {
-	flags := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	if err := run(parseFlags(os.Args)); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// parseFlags parses the command-line arguments.
+func parseFlags(args []string) *options {
+	flags := flag.NewFlagSet(args[0], flag.ExitOnError)
 	flags.Usage = printUsage
+	var o options
 
 	// Define flags with both long and short forms
-	showFlag := flags.String("show", "", "Show specific palette or palette family (e.g., 'catppuccin', 'dark', 'mocha')")
+	flags.StringVar(&o.show, "show", "", "Show specific palette or palette family (e.g., 'catppuccin', 'dark', 'mocha')")
 	shortShow := flags.String("s", "", "")
 	flags.Lookup("s").Usage = flags.Lookup("show").Usage
 
-	listFlag := flags.Bool("list", false, "List all available palettes")
+	flags.BoolVar(&o.list, "list", false, "List all available palettes")
 	shortList := flags.Bool("l", false, "")
 	flags.Lookup("l").Usage = flags.Lookup("list").Usage
+	flags.BoolVar(&o.browse, "browse", false, "Browse the palettes interactively (filter, preview, copy colors, export with -format)")
+	flags.BoolVar(&o.long, "long", false, "Include palette details (author, upstream URL, license...) in the list")
+
+	flags.StringVar(&o.contrast, "contrast", "", "Show the WCAG/APCA contrast matrix of a palette against its backgrounds")
+
+	flags.StringVar(&o.compare, "compare", "", "Compare palettes side by side (comma-separated names, the first one being the reference)")
+	flags.StringVar(&o.match, "match", palette.MatchRole.String(), "Pair the compared colors by semantic role or by nearest color: role or nearest")
+
+	flags.StringVar(&o.export, "export", "", "Export a palette in a machine-readable format (see -format)")
+	flags.StringVar(&o.format, "format", string(export.FormatJSON), "Export format: json, yaml, toml, alacritty, kitty, wezterm, ghostty or foot")
 
-	helpFlag := flags.Bool("help", false, "Show help information")
+	flags.StringVar(&o.genGo, "gen-go", "", "Generate Go code declaring lipgloss colors for a palette, family or family query")
+	flags.StringVar(&o.pkg, "package", export.DefaultGoPackage, "Package name of the generated Go code")
+
+	flags.StringVar(&o.apply, "apply", "", "Apply a palette to the running terminal with OSC escape sequences")
+	flags.BoolVar(&o.reset, "reset", false, "Restore the default colors of the running terminal")
+
+	flags.StringVar(&o.filter, "filter", "", "Only include palettes matching a family query (e.g., 'dark & !catppuccin')")
+
+	flags.StringVar(&o.layout, "layout", palette.LayoutText.String(), "Layout of the shown palettes: text, strip, grid, table or sample")
+	flags.StringVar(&o.profile, "profile", "", "Preview palettes as rendered with a color profile: truecolor, 256, 16 or ascii")
+
+	flags.StringVar(&o.importFile, "import", "", "Import a base16/base24 scheme file (YAML) as a palette")
+	flags.StringVar(&o.paletteDir, "palette-dir", "", "Load additional palette files (JSON, YAML or TOML) from a directory")
+
+	flags.BoolVar(&o.help, "help", false, "Show help information")
 	shortHelp := flags.Bool("h", false, "")
 	flags.Lookup("h").Usage = flags.Lookup("help").Usage
 
-	versionFlag := flags.Bool("version", false, "Show version information")
+	flags.BoolVar(&o.version, "version", false, "Show version information")
 	shortVersion := flags.Bool("v", false, "")
 	flags.Lookup("v").Usage = flags.Lookup("version").Usage
 
-	if err := flags.Parse(os.Args[1:]); err != nil {
+	if err := flags.Parse(args[1:]); err != nil {
 		os.Exit(1)
 	}
 
-	// Handle version flag
-	if *versionFlag || *shortVersion {
-		fmt.Printf("palettes version %s\nCheck https://github.com/dr8co/palettes for updates.\n", version)
-		return
+	if *shortShow != "" {
+		o.show = *shortShow
 	}
+	o.list = o.list || *shortList
+	o.help = o.help || *shortHelp
+	o.version = o.version || *shortVersion
+	flags.Visit(func(f *flag.Flag) {
+		o.layoutSet = o.layoutSet || f.Name == "layout"
+	})
+	return &o
+}
 
-	// Handle help flag
-	if *helpFlag || *shortHelp {
+// run executes the command selected by the options.
+func run(o *options) error {
+	switch {
+	case o.version:
+		fmt.Printf("palettes version %s\nCheck https://github.com/dr8co/palettes for updates.\n", version)
+		return nil
+	case o.help:
 		printUsage()
-		return
+		return nil
+	case o.reset:
+		return export.WriteOSCReset(os.Stdout, oscOptions())
+	}
+
+	render, err := renderer(o)
+	if err != nil {
+		return err
+	}
+
+	reg, imported, err := loadRegistry(o)
+	if err != nil {
+		return err
 	}
 
-	// Initialize the registry with all available schemes
+	// Styled output is downsampled to the colors supported by the terminal, and honors NO_COLOR
+	stdout := colorprofile.NewWriter(os.Stdout, os.Environ())
+
+	if handled, err := runAction(o, stdout, reg); handled {
+		return err
+	}
+	return showPalettes(o, stdout, reg, imported, render)
+}
+
+// oscOptions returns the options of the sequences changing the terminal colors,
+// which must go through tmux to reach the terminal.
+func oscOptions() export.OSCOptions {
+	return export.OSCOptions{Tmux: os.Getenv("TMUX") != ""}
+}
+
+// renderer returns the function wrapping the shown palettes: a preview with the requested
+// color profile, or a view laid out to fit the terminal.
+func renderer(o *options) (func(*palette.Palette) registry.ColorScheme, error) {
+	// Profile previews have their own layout
+	if o.layoutSet && o.profile != "" {
+		return nil, errors.New("-layout cannot be combined with -profile")
+	}
+
+	if o.profile != "" {
+		profile, err := parseProfile(o.profile)
+		if err != nil {
+			return nil, err
+		}
+		return func(p *palette.Palette) registry.ColorScheme {
+			preview := p.Preview(profile)
+			preview.Width = palette.TerminalWidth()
+			return preview
+		}, nil
+	}
+
+	layout, err := palette.ParseLayout(o.layout)
+	if err != nil {
+		return nil, err
+	}
+	return func(p *palette.Palette) registry.ColorScheme {
+		return p.WithLayout(layout, palette.TerminalWidth())
+	}, nil
+}
+
+// loadRegistry returns a registry of the built-in palettes, the user palettes and the imported
+// palette (also returned, or nil), restricted to the palettes matching the filter.
+func loadRegistry(o *options) (*registry.SchemeRegistry, *palette.Palette, error) {
 	reg := registry.NewSchemeRegistry()
-	palette.RegisterAllSchemes(reg)
+	if err := palette.RegisterAllSchemes(reg); err != nil {
+		return nil, nil, err
+	}
 
-	// Handle list flag
-	if *listFlag || *shortList {
-		printPaletteList(reg)
-		return
+	if err := registerUserPalettes(reg, o.paletteDir); err != nil {
+		return nil, nil, err
 	}
 
-	// Handle show flag
-	showValue := *showFlag
-	if *shortShow != "" {
-		showValue = *shortShow
+	var imported *palette.Palette
+	if o.importFile != "" {
+		p, err := palette.ImportBase16File(o.importFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		reg.Upsert(p)
+		imported = p
 	}
-	if showValue != "" {
-		err := handleShowCommand(reg, showValue)
+
+	if o.filter != "" {
+		filtered, err := filterRegistry(reg, o.filter)
 		if err != nil {
-			_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
+			return nil, nil, err
 		}
-		return
+		reg = filtered
 	}
+	return reg, imported, nil
+}
+
+// runAction runs the command of the action flags (-browse, -list, -contrast, -compare, -export,
+// -gen-go and -apply), reporting whether one was given.
+func runAction(o *options, stdout io.Writer, reg *registry.SchemeRegistry) (bool, error) {
+	switch {
+	case o.browse:
+		return true, handleBrowseCommand(reg, o.format)
+	case o.list:
+		printPaletteList(os.Stdout, reg, o.long)
+		return true, nil
+	case o.contrast != "":
+		return true, handleContrastCommand(stdout, reg, o.contrast)
+	case o.compare != "":
+		return true, handleCompareCommand(stdout, reg, o.compare, o.match)
+	case o.export != "":
+		return true, handleExportCommand(os.Stdout, reg, o.export, o.format)
+	case o.genGo != "":
+		return true, handleGenGoCommand(os.Stdout, reg, o.genGo, o.pkg)
+	case o.apply != "":
+		return true, handleApplyCommand(os.Stdout, reg, o.apply, oscOptions())
+	}
+	return false, nil
+}
 
-	// Default: show all palettes
-	reg.ShowAll()
+// showPalettes shows the palettes requested with -show, the imported palette if no other
+// action was requested, or all the palettes.
+func showPalettes(o *options, stdout io.Writer, reg *registry.SchemeRegistry, imported *palette.Palette,
+	render func(*palette.Palette) registry.ColorScheme,
+) error {
+	reg = mapRegistry(reg, render)
+
+	switch {
+	case o.show != "":
+		return handleShowCommand(stdout, reg, o.show)
+	case imported != nil && o.filter == "":
+		_, err := render(imported).WriteTo(stdout)
+		return err
+	default:
+		_, err := reg.WriteTo(stdout)
+		return err
+	}
+}
+
+// registerUserPalettes loads the palette files of a directory into the registry.
+// User palettes replace built-in palettes of the same name.
+//
+// If dir is empty, the default directory ($XDG_CONFIG_HOME/palettes, or the platform's
+// user configuration directory) is used, and it is not an error for it not to exist.
+func registerUserPalettes(reg *registry.SchemeRegistry, dir string) error {
+	if dir == "" {
+		dir = defaultPaletteDir()
+		if dir == "" {
+			return nil
+		}
+		if _, err := os.Stat(dir); errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+	}
+
+	palettes, err := palette.LoadDir(dir)
+	if err != nil {
+		return fmt.Errorf("loading user palettes: %w", err)
+	}
+
+	// User palettes take precedence over the built-in ones, but must not collide with each other
+	// (names are compared like the registry does, ignoring case, accents and separators)
+	seen := make(map[string]string, len(palettes))
+	for _, p := range palettes {
+		key := registry.NameKey(p.Name())
+		if other, ok := seen[key]; ok {
+			return fmt.Errorf("loading user palettes: %w: %s (conflicts with %s)", registry.ErrDuplicateScheme, p.Name(), other)
+		}
+		seen[key] = p.Name()
+
+		reg.Upsert(p)
+	}
+	return nil
+}
+
+// defaultPaletteDir returns the default directory of user palette files,
+// or an empty string if no configuration directory can be determined.
+func defaultPaletteDir() string {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "palettes")
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "palettes")
 }
 
 // printPaletteList displays a list of all available color palettes.
-func printPaletteList(reg *registry.SchemeRegistry) {
-	fmt.Println("Available color palettes:")
-	fmt.Println(strings.Repeat("─", 40))
+// If long is true, the metadata of each palette is shown below its name.
+func printPaletteList(w io.Writer, reg *registry.SchemeRegistry, long bool) {
+	_, _ = fmt.Fprintln(w, "Available color palettes:")
+	_, _ = fmt.Fprintln(w, strings.Repeat("─", 40))
 
 	for _, name := range reg.List() {
 		scheme, _ := reg.Get(name)
 		families := strings.Join(scheme.Families(), ", ")
-		fmt.Printf("  • %-30s [%s]\n", name, families)
+		_, _ = fmt.Fprintf(w, "  • %-30s [%s]\n", name, families)
+		if long {
+			printMetadata(w, scheme.Metadata())
+		}
 	}
 
-	fmt.Println()
-	fmt.Println("Available families:")
-	fmt.Println(strings.Repeat("─", 40))
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintln(w, "Available families:")
+	_, _ = fmt.Fprintln(w, strings.Repeat("─", 40))
 
 	for _, family := range reg.GetFamilies() {
 		count := len(reg.FindByFamily(family))
-		fmt.Printf("  • %-15s (%d palette%s)\n", family, count, pluralize(count))
+		_, _ = fmt.Fprintf(w, "  • %-15s (%d palette%s)\n", family, count, pluralize(count))
 	}
 }
 
+// printMetadata displays the metadata of a palette in the long list format.
+func printMetadata(w io.Writer, meta palette.Metadata) {
+	if meta.IsZero() {
+		return
+	}
+
+	if meta.Description != "" {
+		_, _ = fmt.Fprintf(w, "      %s\n", meta.Description)
+	}
+	for _, field := range [...]struct{ label, value string }{
+		{"Author", meta.Author},
+		{"URL", meta.URL},
+		{"License", meta.License},
+		{"Version", meta.Version},
+	} {
+		if field.value != "" {
+			_, _ = fmt.Fprintf(w, "      %-8s %s\n", field.label+":", field.value)
+		}
+	}
+	_, _ = fmt.Fprintln(w)
+}
+
 // pluralize returns the plural suffix for a given count.
 func pluralize(count int) string {
 	if count == 1 {
@@ -138,47 +424,285 @@ func pluralize(count int) string {
 }
 
 // handleShowCommand processes the '-show' flag to display a specific palette or family.
-func handleShowCommand(reg *registry.SchemeRegistry, query string) error {
+func handleShowCommand(w io.Writer, reg *registry.SchemeRegistry, query string) error {
 	query = strings.TrimSpace(strings.ToLower(query))
 
-	// Try an exact match first (case-insensitive)
-	for _, name := range reg.List() {
-		if strings.ToLower(name) == query {
-			if scheme, exists := reg.Get(name); exists {
-				scheme.Show()
-				return nil
-			}
+	// Try an exact match first (ignoring case, accents and separators), so that names
+	// containing query operators (e.g., "Black Metal (Bathory)") can still be shown
+	if scheme, exists := reg.Lookup(query); exists {
+		_, err := scheme.WriteTo(w)
+		return err
+	}
+
+	// A family query (e.g., "dark & !catppuccin") shows all matching palettes
+	if registry.IsQuery(query) {
+		matches, err := queryFamilies(reg, query)
+		if err != nil {
+			return err
 		}
+		return writeSchemes(w, fmt.Sprintf("Showing all palettes matching '%s' (%d found):", query, len(matches)), matches)
 	}
 
 	// Try a family match (e.g., "catppuccin", "dark", "light")
 	matches := reg.FindByFamily(query)
 	if len(matches) > 0 {
-		fmt.Printf("Showing all '%s' palette variants (%d found):\n", query, len(matches))
-		fmt.Println(strings.Repeat("═", 60))
-		fmt.Println()
-
-		for _, scheme := range matches {
-			scheme.Show()
-		}
-		return nil
+		return writeSchemes(w, fmt.Sprintf("Showing all '%s' palette variants (%d found):", query, len(matches)), matches)
 	}
 
 	// Try a partial match
 	partialMatches := reg.FindByPartialName(query)
 	if len(partialMatches) > 0 {
 		if len(partialMatches) == 1 {
-			partialMatches[0].Show()
-			return nil
+			_, err := partialMatches[0].WriteTo(w)
+			return err
 		}
 
-		fmt.Printf("Multiple palettes match '%s':\n", query)
+		_, _ = fmt.Fprintf(w, "Multiple palettes match '%s':\n", query)
 		for _, scheme := range partialMatches {
 			families := strings.Join(scheme.Families(), ", ")
-			fmt.Printf("  • %-30s [%s]\n", scheme.Name(), families)
+			_, _ = fmt.Fprintf(w, "  • %-30s [%s]\n", scheme.Name(), families)
 		}
 		return errors.New("please be more specific")
 	}
 
+	// Fall back to a fuzzy match, tolerating typos and missing spaces
+	fuzzyMatches := reg.FuzzyFind(query)
+	if scheme, ok := registry.ClearWinner(fuzzyMatches); ok {
+		_, err := scheme.WriteTo(w)
+		return err
+	}
+	if len(fuzzyMatches) > 0 {
+		_, _ = fmt.Fprintln(w, "Did you mean:")
+		for _, match := range suggestions(fuzzyMatches) {
+			families := strings.Join(match.Scheme.Families(), ", ")
+			_, _ = fmt.Fprintf(w, "  • %-30s [%s]\n", match.Scheme.Name(), families)
+		}
+	}
+
 	return fmt.Errorf("no palette found matching '%s'", query)
 }
+
+// writeSchemes writes a header followed by the given schemes.
+func writeSchemes(w io.Writer, header string, schemes []registry.ColorScheme) error {
+	_, _ = fmt.Fprintln(w, header)
+	_, _ = fmt.Fprintln(w, strings.Repeat("═", 60))
+	_, _ = fmt.Fprintln(w)
+
+	for _, scheme := range schemes {
+		if _, err := scheme.WriteTo(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queryFamilies returns the schemes matching a family query.
+// If none matches, the error names the query families that no scheme belongs to, if any.
+func queryFamilies(reg *registry.SchemeRegistry, expr string) ([]registry.ColorScheme, error) {
+	query, err := registry.ParseQuery(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := reg.Filter(query)
+	if len(matches) > 0 {
+		return matches, nil
+	}
+
+	var unknown []string
+	for _, family := range query.Families() {
+		if !reg.HasFamily(family) {
+			unknown = append(unknown, "'"+family+"'")
+		}
+	}
+	switch len(unknown) {
+	case 0:
+	case 1:
+		return nil, fmt.Errorf("no palette matches '%s' (unknown family %s)", query, unknown[0])
+	default:
+		return nil, fmt.Errorf("no palette matches '%s' (unknown families %s)", query, strings.Join(unknown, ", "))
+	}
+	return nil, fmt.Errorf("no palette matches '%s'", query)
+}
+
+// filterRegistry returns a registry holding only the schemes matching a family query.
+func filterRegistry(reg *registry.SchemeRegistry, expr string) (*registry.SchemeRegistry, error) {
+	matches, err := queryFamilies(reg, expr)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := registry.NewSchemeRegistry()
+	for _, scheme := range matches {
+		filtered.Register(scheme)
+	}
+	return filtered, nil
+}
+
+// parseProfile parses the name of a color profile given to the '-profile' flag.
+func parseProfile(name string) (colorprofile.Profile, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "truecolor", "24bit":
+		return colorprofile.TrueColor, nil
+	case "256", "ansi256":
+		return colorprofile.ANSI256, nil
+	case "16", "ansi":
+		return colorprofile.ANSI, nil
+	case "ascii", "none":
+		return colorprofile.ASCII, nil
+	default:
+		return colorprofile.Unknown, fmt.Errorf("unsupported color profile '%s' (supported: truecolor, 256, 16, ascii)", name)
+	}
+}
+
+// mapRegistry returns a registry holding the palettes of reg converted by fn, such as their
+// previews under a color profile. Schemes that are not palettes are kept as they are.
+func mapRegistry(reg *registry.SchemeRegistry, fn func(*palette.Palette) registry.ColorScheme) *registry.SchemeRegistry {
+	mapped := registry.NewSchemeRegistry()
+	for _, name := range reg.List() {
+		scheme, _ := reg.Get(name)
+		if p, ok := scheme.(*palette.Palette); ok {
+			scheme = fn(p)
+		}
+		mapped.Register(scheme)
+	}
+	return mapped
+}
+
+// suggestions returns the best fuzzy matches to suggest to the user.
+func suggestions(matches []registry.Match) []registry.Match {
+	const maxSuggestions = 5
+	if len(matches) > maxSuggestions {
+		return matches[:maxSuggestions]
+	}
+	return matches
+}
+
+// handleBrowseCommand processes the '-browse' flag to browse the palettes in a full-screen interface.
+// Palettes are exported from the browser in the given format.
+func handleBrowseCommand(reg *registry.SchemeRegistry, formatName string) error {
+	format, err := export.ParseFormat(formatName)
+	if err != nil {
+		return err
+	}
+
+	return tui.Run(reg, tui.Options{Format: format})
+}
+
+// handleExportCommand processes the '-export' flag to write a palette in a machine-readable format.
+func handleExportCommand(w io.Writer, reg *registry.SchemeRegistry, query, formatName string) error {
+	format, err := export.ParseFormat(formatName)
+	if err != nil {
+		return err
+	}
+
+	p, err := findPalette(reg, query)
+	if err != nil {
+		return err
+	}
+
+	return export.Write(w, p, format)
+}
+
+// handleGenGoCommand processes the '-gen-go' flag to write Go code declaring the lipgloss
+// colors of a palette, a family or the palettes matching a family query.
+func handleGenGoCommand(w io.Writer, reg *registry.SchemeRegistry, query, pkg string) error {
+	palettes, err := findPalettes(reg, query)
+	if err != nil {
+		return err
+	}
+
+	return export.WriteGo(w, palettes, export.GoOptions{Package: pkg})
+}
+
+// handleApplyCommand processes the '-apply' flag to apply the colors of a palette
+// to the running terminal.
+func handleApplyCommand(w io.Writer, reg *registry.SchemeRegistry, query string, opts export.OSCOptions) error {
+	p, err := findPalette(reg, query)
+	if err != nil {
+		return err
+	}
+
+	return export.WriteOSC(w, p, opts)
+}
+
+// findPalettes resolves a query to one or more palettes: the palette of that name,
+// the palettes of a family or matching a family query, or a single palette found by [findPalette].
+func findPalettes(reg *registry.SchemeRegistry, query string) ([]*palette.Palette, error) {
+	query = strings.TrimSpace(strings.ToLower(query))
+
+	var schemes []registry.ColorScheme
+	switch scheme, exists := reg.Lookup(query); {
+	case exists:
+		schemes = []registry.ColorScheme{scheme}
+	case registry.IsQuery(query):
+		matches, err := queryFamilies(reg, query)
+		if err != nil {
+			return nil, err
+		}
+		schemes = matches
+	default:
+		schemes = reg.FindByFamily(query)
+	}
+
+	if len(schemes) == 0 {
+		p, err := findPalette(reg, query)
+		if err != nil {
+			return nil, err
+		}
+		return []*palette.Palette{p}, nil
+	}
+
+	palettes := make([]*palette.Palette, 0, len(schemes))
+	for _, scheme := range schemes {
+		p, ok := scheme.(*palette.Palette)
+		if !ok {
+			return nil, fmt.Errorf("palette '%s' does not provide color definitions", scheme.Name())
+		}
+		palettes = append(palettes, p)
+	}
+	return palettes, nil
+}
+
+// findPalette resolves a query to a single palette, trying an exact (case-insensitive)
+// name match first and then a unique partial name match.
+func findPalette(reg *registry.SchemeRegistry, query string) (*palette.Palette, error) {
+	query = strings.TrimSpace(strings.ToLower(query))
+
+	scheme, exists := reg.Lookup(query)
+	if !exists {
+		matches := reg.FindByPartialName(query)
+		switch len(matches) {
+		case 0:
+			fuzzyMatches := reg.FuzzyFind(query)
+			winner, ok := registry.ClearWinner(fuzzyMatches)
+			if ok {
+				scheme = winner
+				break
+			}
+			if len(fuzzyMatches) > 0 {
+				names := make([]string, 0, len(fuzzyMatches))
+				for _, match := range suggestions(fuzzyMatches) {
+					names = append(names, match.Scheme.Name())
+				}
+				return nil, fmt.Errorf("no palette found matching '%s' (did you mean %s?)", query, strings.Join(names, ", "))
+			}
+			return nil, fmt.Errorf("no palette found matching '%s'", query)
+		case 1:
+			scheme = matches[0]
+		default:
+			names := make([]string, 0, len(matches))
+			for _, match := range matches {
+				names = append(names, match.Name())
+			}
+			return nil, fmt.Errorf("'%s' matches multiple palettes (%s), please be more specific",
+				query, strings.Join(names, ", "))
+		}
+	}
+
+	p, ok := scheme.(*palette.Palette)
+	if !ok {
+		return nil, fmt.Errorf("palette '%s' does not provide color definitions", scheme.Name())
+	}
+	return p, nil
+}
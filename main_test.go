@@ -0,0 +1,355 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/colorprofile"
+	"github.com/dr8co/palettes/export"
+	"github.com/dr8co/palettes/internal/golden"
+	"github.com/dr8co/palettes/palette"
+	"github.com/dr8co/palettes/registry"
+)
+
+// newTestRegistry returns a registry holding the bundled palettes only.
+func newTestRegistry(t *testing.T) *registry.SchemeRegistry {
+	t.Helper()
+
+	reg := registry.NewSchemeRegistry()
+	if err := palette.RegisterAllSchemes(reg); err != nil {
+		t.Fatalf("RegisterAllSchemes: %v", err)
+	}
+	return reg
+}
+
+func TestPrintPaletteList(t *testing.T) {
+	t.Parallel()
+
+	for name, long := range map[string]bool{"list": false, "list-long": true} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			printPaletteList(&buf, newTestRegistry(t), long)
+			golden.Assert(t, name, buf.Bytes())
+		})
+	}
+}
+
+func TestHandleShowCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		query   string
+		extra   *palette.Palette // registered besides the bundled palettes
+		wantErr string
+	}{
+		{name: "show-exact", query: "Catppuccin Mocha"},
+		{
+			name:  "show-exact-operators",
+			query: "Black Metal (Bathory)",
+			extra: palette.NewPalette("Black Metal (Bathory)", "base16", "dark").
+				AddColor("base00", "#000000").AddColor("base05", "#c1c1c1").AddColor("base0D", "#e78a53"),
+		},
+		{name: "show-family", query: "nord"},
+		{name: "show-partial", query: "dracu"},
+		{name: "show-ambiguous", query: "night", wantErr: "please be more specific"},
+		{name: "show-fuzzy", query: "catpucin mocha"},
+		{name: "show-did-you-mean", query: "tokyonight storm", wantErr: "no palette found matching 'tokyonight storm'"},
+		{name: "show-query", query: "dark & pastel & !catppuccin"},
+		{name: "show-query-invalid", query: "dark & (nord", wantErr: "invalid family query 'dark & (nord': missing ')' for this '(' (at position 8)"},
+		{name: "show-not-found", query: "does not exist", wantErr: "no palette found matching 'does not exist'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			w := &colorprofile.Writer{Forward: &buf, Profile: colorprofile.TrueColor}
+			reg := newTestRegistry(t)
+			if tt.extra != nil {
+				reg.Register(tt.extra)
+			}
+			err := handleShowCommand(w, reg, tt.query)
+
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("handleShowCommand(%q) returned error: %v", tt.query, err)
+			case tt.wantErr != "" && err == nil:
+				t.Fatalf("handleShowCommand(%q) succeeded, want error %q", tt.query, tt.wantErr)
+			case tt.wantErr != "" && err.Error() != tt.wantErr:
+				t.Fatalf("handleShowCommand(%q) error = %q, want %q", tt.query, err, tt.wantErr)
+			}
+
+			golden.Assert(t, tt.name, buf.Bytes())
+		})
+	}
+}
+
+func TestBackgroundColors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "dracula", want: []string{"background", "selection"}},
+		{query: "gruvbox dark", want: []string{"bg", "bg2"}},
+		{query: "nord frost", want: []string{"nord10"}},
+	}
+
+	reg := newTestRegistry(t)
+	for _, tt := range tests {
+		p, err := findPalette(reg, tt.query)
+		if err != nil {
+			t.Fatalf("findPalette(%q) returned error: %v", tt.query, err)
+		}
+
+		var got []string
+		for _, bg := range backgroundColors(p) {
+			got = append(got, bg.Def.Name)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("backgroundColors(%s) = %v, want %v", p.Name(), got, tt.want)
+		}
+	}
+}
+
+func TestRegisterUserPalettes(t *testing.T) {
+	t.Parallel()
+
+	const valid = "colors:\n  - {name: bg, hex: \"#101820\"}\n"
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr string
+	}{
+		{
+			name:  "override",
+			files: map[string]string{"dracula.yaml": "name: DRACULA\n" + valid, "acme.yaml": "name: Acme\n" + valid},
+		},
+		{
+			name:    "duplicate",
+			files:   map[string]string{"a.yaml": "name: Acme Dark\n" + valid, "b.yaml": "name: acme-dark\n" + valid},
+			wantErr: "loading user palettes: palette already registered: acme-dark (conflicts with Acme Dark)",
+		},
+		{
+			name:    "duplicate-accents",
+			files:   map[string]string{"a.yaml": "name: Rosé\n" + valid, "b.json": `{"name": "rose", "colors": [{"name": "bg", "hex": "#000000"}]}`},
+			wantErr: "loading user palettes: palette already registered: rose (conflicts with Rosé)",
+		},
+		{
+			name:    "malformed",
+			files:   map[string]string{"a.yaml": "name: Acme\ncolors:\n  - name: bg\n    hex: #101820\n"},
+			wantErr: `palette "Acme": color #1 ("bg"): missing hex value`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			reg := newTestRegistry(t)
+			err := registerUserPalettes(reg, dir)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("registerUserPalettes error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("registerUserPalettes returned error: %v", err)
+			}
+
+			// User palettes replace the built-in ones of the same name
+			if got := len(reg.List()); got != 21 {
+				t.Errorf("registry holds %d palettes, want 21", got)
+			}
+			if scheme, ok := reg.Lookup("dracula"); !ok || scheme.Name() != "DRACULA" {
+				t.Errorf("Lookup(dracula) = %v, want the user palette DRACULA", scheme)
+			}
+		})
+	}
+}
+
+func TestHandleGenGoCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		query   string
+		pkg     string
+		wantErr string
+	}{
+		{name: "gen-go-palette", query: "mocha", pkg: "theme"},
+		{name: "gen-go-family", query: "gruvbox", pkg: "gruvbox"},
+		{name: "gen-go-invalid-package", query: "nord", pkg: "func", wantErr: "invalid Go package name 'func'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			err := handleGenGoCommand(&buf, newTestRegistry(t), tt.query, tt.pkg)
+
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("handleGenGoCommand(%q) returned error: %v", tt.query, err)
+			case tt.wantErr != "" && err == nil:
+				t.Fatalf("handleGenGoCommand(%q) succeeded, want error %q", tt.query, tt.wantErr)
+			case tt.wantErr != "" && err.Error() != tt.wantErr:
+				t.Fatalf("handleGenGoCommand(%q) error = %q, want %q", tt.query, err, tt.wantErr)
+			}
+
+			if tt.wantErr == "" {
+				golden.Assert(t, tt.name, buf.Bytes())
+			}
+		})
+	}
+}
+
+func TestProfilePreview(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"truecolor", "256", "16", "ascii"} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			profile, err := parseProfile(name)
+			if err != nil {
+				t.Fatalf("parseProfile(%q) returned error: %v", name, err)
+			}
+
+			var buf bytes.Buffer
+			w := &colorprofile.Writer{Forward: &buf, Profile: colorprofile.TrueColor}
+			preview := func(p *palette.Palette) registry.ColorScheme { return p.Preview(profile) }
+			if err := handleShowCommand(w, mapRegistry(newTestRegistry(t), preview), "dracula"); err != nil {
+				t.Fatalf("handleShowCommand(dracula) returned error: %v", err)
+			}
+			golden.Assert(t, "profile-"+name, buf.Bytes())
+		})
+	}
+
+	const wantErr = "unsupported color profile '8' (supported: truecolor, 256, 16, ascii)"
+	if _, err := parseProfile("8"); err == nil || err.Error() != wantErr {
+		t.Errorf("parseProfile(8) error = %v, want %q", err, wantErr)
+	}
+}
+
+func TestHandleApplyCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		opts export.OSCOptions
+	}{
+		{name: "apply-dracula"},
+		{name: "apply-dracula-tmux", opts: export.OSCOptions{Tmux: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			if err := handleApplyCommand(&buf, newTestRegistry(t), "dracula", tt.opts); err != nil {
+				t.Fatalf("handleApplyCommand(dracula) returned error: %v", err)
+			}
+			golden.Assert(t, tt.name, buf.Bytes())
+		})
+	}
+
+	// The palette of the documented example must be found
+	if err := handleApplyCommand(io.Discard, newTestRegistry(t), "tokyo night dark", export.OSCOptions{}); err != nil {
+		t.Errorf("handleApplyCommand(tokyo night dark) returned error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := export.WriteOSCReset(&buf, export.OSCOptions{}); err != nil {
+		t.Fatalf("WriteOSCReset returned error: %v", err)
+	}
+	if want := "\x1b]104\x07\x1b]110\x07\x1b]111\x07\x1b]112\x07"; buf.String() != want {
+		t.Errorf("WriteOSCReset wrote %q, want %q", buf.String(), want)
+	}
+}
+
+func TestLayouts(t *testing.T) {
+	t.Parallel()
+
+	for _, layout := range palette.Layouts() {
+		for _, width := range []int{60, 100} {
+			name := fmt.Sprintf("layout-%s-%d", layout, width)
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				var buf bytes.Buffer
+				w := &colorprofile.Writer{Forward: &buf, Profile: colorprofile.TrueColor}
+				render := func(p *palette.Palette) registry.ColorScheme { return p.WithLayout(layout, width) }
+				if err := handleShowCommand(w, mapRegistry(newTestRegistry(t), render), "dracula"); err != nil {
+					t.Fatalf("handleShowCommand(dracula) returned error: %v", err)
+				}
+				golden.Assert(t, name, buf.Bytes())
+			})
+		}
+	}
+
+	const wantErr = "unsupported layout 'list' (supported: text, strip, grid, table, sample)"
+	if _, err := palette.ParseLayout("list"); err == nil || err.Error() != wantErr {
+		t.Errorf("ParseLayout(list) error = %v, want %q", err, wantErr)
+	}
+}
+
+func TestHandleCompareCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		names   string
+		match   string
+		wantErr string
+	}{
+		{name: "compare-role", names: "macchiato, tokyo night dark", match: "role"},
+		{name: "compare-nearest", names: "nord frost,dracula,gruvbox dark", match: "nearest"},
+		{name: "compare-single", names: "mocha", match: "role", wantErr: "comparing palettes requires at least two comma-separated palette names"},
+		{name: "compare-invalid-match", names: "mocha,latte", match: "hue", wantErr: "unsupported match mode 'hue' (supported: role, nearest)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			w := &colorprofile.Writer{Forward: &buf, Profile: colorprofile.TrueColor}
+			err := handleCompareCommand(w, newTestRegistry(t), tt.names, tt.match)
+
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("handleCompareCommand(%q) returned error: %v", tt.names, err)
+			case tt.wantErr != "" && err == nil:
+				t.Fatalf("handleCompareCommand(%q) succeeded, want error %q", tt.names, tt.wantErr)
+			case tt.wantErr != "" && err.Error() != tt.wantErr:
+				t.Fatalf("handleCompareCommand(%q) error = %q, want %q", tt.names, err, tt.wantErr)
+			}
+
+			if tt.wantErr == "" {
+				golden.Assert(t, tt.name, buf.Bytes())
+			}
+		})
+	}
+}
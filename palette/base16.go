@@ -0,0 +1,191 @@
+package palette
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// base16Names holds the color names of a base16 scheme, in order.
+var base16Names = []string{
+	"base00", "base01", "base02", "base03", "base04", "base05", "base06", "base07",
+	"base08", "base09", "base0A", "base0B", "base0C", "base0D", "base0E", "base0F",
+}
+
+// base24Names holds the additional color names of a base24 scheme, in order.
+var base24Names = []string{"base10", "base11", "base12", "base13", "base14", "base15", "base16", "base17"}
+
+// base16Roles maps the semantic roles to base16 colors, following the base16 styling
+// guidelines and the base16-shell terminal mapping.
+var base16Roles = map[Role]string{
+	RoleBackground:    "base00",
+	RoleForeground:    "base05",
+	RoleCursor:        "base05",
+	RoleSelection:     "base02",
+	RoleBlack:         "base00",
+	RoleRed:           "base08",
+	RoleGreen:         "base0B",
+	RoleYellow:        "base0A",
+	RoleBlue:          "base0D",
+	RoleMagenta:       "base0E",
+	RoleCyan:          "base0C",
+	RoleWhite:         "base05",
+	RoleBrightBlack:   "base03",
+	RoleBrightRed:     "base08",
+	RoleBrightGreen:   "base0B",
+	RoleBrightYellow:  "base0A",
+	RoleBrightBlue:    "base0D",
+	RoleBrightMagenta: "base0E",
+	RoleBrightCyan:    "base0C",
+	RoleBrightWhite:   "base07",
+	RoleAccent:        "base0D",
+	RoleError:         "base08",
+	RoleWarning:       "base0A",
+	RoleInfo:          "base0C",
+}
+
+// base24Roles overrides the bright ANSI colors of [base16Roles] with the dedicated base24 colors.
+var base24Roles = map[Role]string{
+	RoleBrightRed:     "base12",
+	RoleBrightYellow:  "base13",
+	RoleBrightGreen:   "base14",
+	RoleBrightCyan:    "base15",
+	RoleBrightBlue:    "base16",
+	RoleBrightMagenta: "base17",
+}
+
+// ImportBase16 reads a base16 or base24 scheme in YAML and converts it to a palette.
+//
+// Both the original format (top-level "scheme", "author" and "base00" to "base0F" keys)
+// and the Tinted Theming format ("system", "name", "variant" and a "palette" mapping)
+// are supported. A scheme is treated as base24 when it declares the base24 system or
+// defines any of the "base10" to "base17" colors.
+//
+// The palette belongs to the "base16" or "base24" family, and to "dark" or "light"
+// depending on the declared variant, or else on the lightness of base00.
+// The scheme author and description, if any, are kept as metadata.
+func ImportBase16(r io.Reader) (*Palette, error) {
+	// Decode to nodes to keep the raw scalar text: unquoted values such as 000000 would otherwise become numbers
+	var raw map[string]yaml.Node
+	if err := yaml.NewDecoder(r).Decode(&raw); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("empty base16 scheme")
+		}
+		return nil, fmt.Errorf("invalid base16 YAML: %w", err)
+	}
+
+	name := firstString(raw, "name", "scheme")
+	if name == "" {
+		return nil, errors.New("base16 scheme has no name ('scheme' or 'name' key)")
+	}
+
+	// Newer schemes nest the colors under "palette"; older ones keep them at the top level
+	values := raw
+	if nested, ok := raw["palette"]; ok {
+		values = nil
+		if err := nested.Decode(&values); err != nil {
+			return nil, fmt.Errorf("invalid base16 palette: %w", err)
+		}
+	}
+	hexes := make(map[string]string, len(values))
+	for key, value := range values {
+		if value.Kind == yaml.ScalarNode {
+			hexes[strings.ToLower(key)] = value.Value
+		}
+	}
+
+	system := "base16"
+	names := base16Names
+	if strings.EqualFold(firstString(raw, "system"), "base24") || hasAny(hexes, base24Names) {
+		system = "base24"
+		names = append(append([]string(nil), base16Names...), base24Names...)
+	}
+
+	var missing []string
+	for _, colorName := range names {
+		if _, ok := hexes[strings.ToLower(colorName)]; !ok {
+			missing = append(missing, colorName)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%s scheme %q is missing %s", system, name, strings.Join(missing, ", "))
+	}
+
+	p := NewPalette(name, system)
+	for _, colorName := range names {
+		hex := strings.TrimSpace(hexes[strings.ToLower(colorName)])
+		if !strings.HasPrefix(hex, "#") {
+			hex = "#" + hex
+		}
+		p.AddColor(colorName, strings.ToLower(hex))
+	}
+
+	p.SetRoles(base16Roles)
+	if system == "base24" {
+		p.SetRoles(base24Roles)
+	}
+
+	if err := p.Err(); err != nil {
+		return nil, err
+	}
+
+	p.AddFamily(base16Variant(p, firstString(raw, "variant")))
+	p.SetMetadata(Metadata{
+		Author:      firstString(raw, "author"),
+		Description: firstString(raw, "description"),
+	})
+	return p, nil
+}
+
+// ImportBase16File reads a base16 or base24 scheme file. See [ImportBase16].
+func ImportBase16File(path string) (*Palette, error) {
+	f, err := os.Open(path) //nolint:gosec // importing user-selected files is the purpose of this function
+	if err != nil {
+		return nil, fmt.Errorf("opening base16 scheme: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	p, err := ImportBase16(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return p, nil
+}
+
+// base16Variant returns the declared variant ("dark" or "light") of a scheme,
+// or infers it from the CIE lightness of its base00 color.
+func base16Variant(p *Palette, declared string) string {
+	declared = strings.ToLower(strings.TrimSpace(declared))
+	if declared == "dark" || declared == "light" {
+		return declared
+	}
+
+	if p.colors[0].Value.Lab().L < 50 {
+		return "dark"
+	}
+	return "light"
+}
+
+// firstString returns the first non-empty scalar value among the given keys.
+func firstString(raw map[string]yaml.Node, keys ...string) string {
+	for _, key := range keys {
+		if node, ok := raw[key]; ok && node.Kind == yaml.ScalarNode && strings.TrimSpace(node.Value) != "" {
+			return strings.TrimSpace(node.Value)
+		}
+	}
+	return ""
+}
+
+// hasAny reports whether any of the names (case-insensitive) is a key of m.
+func hasAny(m map[string]string, names []string) bool {
+	for _, name := range names {
+		if _, ok := m[strings.ToLower(name)]; ok {
+			return true
+		}
+	}
+	return false
+}
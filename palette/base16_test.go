@@ -0,0 +1,143 @@
+package palette_test
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/dr8co/palettes/palette"
+)
+
+func TestImportBase16(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		file        string
+		name        string
+		families    []string
+		colors      int
+		author      string
+		description string
+		brightRed   string // hex value of the bright red role
+	}{
+		{
+			file:     "classic.yaml",
+			name:     "Ocean",
+			families: []string{"base16", "dark"},
+			colors:   16,
+			author:   "Chris Kempson (http://chriskempson.com)",
+			// base16 schemes share the normal and bright colors
+			brightRed: "#bf616a",
+		},
+		{
+			file:      "classic-unquoted-light.yaml",
+			name:      "Ocean Light",
+			families:  []string{"base16", "light"},
+			colors:    16,
+			author:    "Chris Kempson",
+			brightRed: "#bf616a",
+		},
+		{
+			file:        "tinted.yaml",
+			name:        "Ocean Tinted",
+			families:    []string{"base16", "light"}, // declared, despite the dark base00
+			colors:      16,
+			author:      "Chris Kempson (http://chriskempson.com)",
+			description: "The Ocean scheme, in the Tinted Theming format",
+			brightRed:   "#bf616a",
+		},
+		{
+			file:      "tinted-base24.yaml",
+			name:      "Ocean 24",
+			families:  []string{"base24", "dark"},
+			colors:    24,
+			author:    "Tinted Theming",
+			brightRed: "#ff7f87",
+		},
+		{
+			file:      "detected-base24.yaml",
+			name:      "Ocean 24 Detected",
+			families:  []string{"base24", "dark"},
+			colors:    24,
+			author:    "Tinted Theming",
+			brightRed: "#ff7f87",
+		},
+	}
+
+	for _, tt := range tests {
+		p, err := palette.ImportBase16File(filepath.Join("testdata", "base16", tt.file))
+		if err != nil {
+			t.Errorf("ImportBase16File(%s): %v", tt.file, err)
+			continue
+		}
+
+		if p.Name() != tt.name || !slices.Equal(p.Families(), tt.families) {
+			t.Errorf("%s: imported %s %v, want %s %v", tt.file, p.Name(), p.Families(), tt.name, tt.families)
+		}
+		if meta := p.Metadata(); meta.Author != tt.author || meta.Description != tt.description {
+			t.Errorf("%s: metadata = %+v, want author %q and description %q", tt.file, meta, tt.author, tt.description)
+		}
+
+		defs := p.Definitions()
+		if len(defs) != tt.colors {
+			t.Errorf("%s: imported %d colors, want %d", tt.file, len(defs), tt.colors)
+			continue
+		}
+		if defs[0].Name != "base00" || defs[len(defs)-1].Name != map[int]string{16: "base0F", 24: "base17"}[tt.colors] {
+			t.Errorf("%s: colors are not in base16 order: %v", tt.file, defs)
+		}
+		for _, def := range defs {
+			if def.Hex != strings.ToLower(def.Hex) || !strings.HasPrefix(def.Hex, "#") {
+				t.Errorf("%s: color %s is not a normalized hex value", tt.file, def)
+			}
+		}
+
+		roles := p.RoleColors()
+		if bg := roles[palette.RoleBackground]; bg.Name != "base00" {
+			t.Errorf("%s: background role = %v, want base00", tt.file, bg)
+		}
+		if red := roles[palette.RoleBrightRed]; red.Hex != tt.brightRed {
+			t.Errorf("%s: bright red role = %v, want %s", tt.file, red, tt.brightRed)
+		}
+	}
+}
+
+func TestImportBase16Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		file    string
+		wantErr string
+	}{
+		{file: "missing.yaml", wantErr: `base16 scheme "Ocean Broken" is missing base0E, base0F`},
+		{file: "missing-base24.yaml", wantErr: `base24 scheme "Ocean 24 Broken" is missing base15`},
+	}
+
+	for _, tt := range tests {
+		path := filepath.Join("testdata", "base16", tt.file)
+		_, err := palette.ImportBase16File(path)
+		if want := path + ": " + tt.wantErr; err == nil || err.Error() != want {
+			t.Errorf("ImportBase16File(%s) error = %v, want %q", tt.file, err, want)
+		}
+	}
+
+	// A scheme whose base00 is not a hex value
+	invalidHex := "scheme: X\nbase00: zzzzzz\n"
+	for _, name := range []string{"01", "02", "03", "04", "05", "06", "07", "08", "09", "0A", "0B", "0C", "0D", "0E", "0F"} {
+		invalidHex += "base" + name + ": 000000\n"
+	}
+
+	for input, wantErr := range map[string]string{
+		"":                          "empty base16 scheme",
+		"author: Jane\nbase00: 000": "base16 scheme has no name ('scheme' or 'name' key)",
+		"scheme: [unclosed":         "invalid base16 YAML: ",
+		"name: X\npalette: [1, 2]":  "invalid base16 palette: ",
+		invalidHex:                  `palette "X": color "base00": invalid hex color "#zzzzzz"`,
+	} {
+		_, err := palette.ImportBase16(strings.NewReader(input))
+		if err == nil || !strings.HasPrefix(err.Error(), wantErr) {
+			t.Errorf("ImportBase16(%q) error = %v, want %q", input, err, wantErr)
+		}
+	}
+}
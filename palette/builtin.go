@@ -0,0 +1,124 @@
+package palette
+
+import (
+	"embed"
+	"strings"
+)
+
+// builtinData holds the definition files of the built-in palettes, one palette per file,
+// in the same format as user palettes (see [LoadFile]).
+//
+//go:embed data/*.yaml
+var builtinData embed.FS
+
+// builtins holds the built-in palettes, in file name order, and builtinErr the errors of the
+// malformed definition files (reported by [RegisterAllSchemes]).
+var builtins, builtinErr = LoadFS(builtinData, "data")
+
+// CatppuccinColors contains the color definitions for all the Catppuccin variants.
+// Check https://catppuccin.com/palette for more information.
+var CatppuccinColors = builtinVariants("Catppuccin")
+
+// TokyoNightColors contains the color definitions for all the Tokyo Night variants.
+// Check https://github.com/tokyo-night/tokyo-night-vscode-theme for more information.
+var TokyoNightColors = builtinVariants("Tokyo Night")
+
+// RosePineColors contains the color definitions for all the Rosé Pine variants.
+// The main variant has an empty key.
+// Check https://rosepinetheme.com/palette/ingredients for more information.
+var RosePineColors = builtinVariants("Rosé Pine")
+
+// NordColors contains the color definitions for the Nord theme.
+// Check https://www.nordtheme.com/docs/colors-and-palettes for more information.
+var NordColors = builtinVariants("Nord")
+
+// GruvboxColors contains the color definitions for the classic Gruvbox themes.
+// Check https://github.com/morhetz/gruvbox for more information.
+var GruvboxColors = builtinVariants("Gruvbox")
+
+// CreateCatppuccinPalette creates a Catppuccin palette variant with appropriate families.
+func CreateCatppuccinPalette(variant string) *Palette {
+	return createBuiltin("Catppuccin", variant)
+}
+
+// CreateTokyoNightPalette creates a Tokyo Night palette variant with appropriate families.
+func CreateTokyoNightPalette(variant string) *Palette {
+	return createBuiltin("Tokyo Night", variant)
+}
+
+// CreateRosePinePalette creates a Rosé Pine palette variant with appropriate families.
+func CreateRosePinePalette(variant string) *Palette {
+	return createBuiltin("Rosé Pine", variant)
+}
+
+// CreateNordPalette creates a Nord palette variant with appropriate families.
+func CreateNordPalette(variant string) *Palette {
+	return createBuiltin("Nord", variant)
+}
+
+// CreateGruvboxPalette creates a Gruvbox palette with appropriate families.
+func CreateGruvboxPalette(variant string) *Palette {
+	return createBuiltin("Gruvbox", variant)
+}
+
+// CreateEldritchPalette creates the Eldritch color palette.
+func CreateEldritchPalette() *Palette {
+	return createBuiltin("Eldritch", "")
+}
+
+// CreateDraculaPalette creates the Dracula color palette.
+func CreateDraculaPalette() *Palette {
+	return createBuiltin("Dracula", "")
+}
+
+// CreateMonokaiProPalette creates the Monokai Pro color palette.
+func CreateMonokaiProPalette() *Palette {
+	return createBuiltin("Monokai Pro", "")
+}
+
+// CreateSolarizedPalette creates a new Solarized color palette.
+func CreateSolarizedPalette() *Palette {
+	return createBuiltin("Solarized", "")
+}
+
+// CreateEverblushPalette creates the Everblush color palette.
+func CreateEverblushPalette() *Palette {
+	return createBuiltin("Everblush", "")
+}
+
+// createBuiltin returns a copy of a built-in palette, named after its family and variant
+// (case-insensitive), or nil if there is no such palette.
+func createBuiltin(family, variant string) *Palette {
+	variant = strings.ToLower(strings.TrimSpace(variant))
+	for _, p := range builtins {
+		if v, ok := variantOf(p.Name(), family); ok && v == variant {
+			return p.clone()
+		}
+	}
+	return nil
+}
+
+// builtinVariants returns the color definitions of the built-in palettes of a family, by variant.
+func builtinVariants(family string) map[string][]ColorDefinition {
+	variants := make(map[string][]ColorDefinition)
+	for _, p := range builtins {
+		if variant, ok := variantOf(p.Name(), family); ok {
+			variants[variant] = p.Document().Colors
+		}
+	}
+	return variants
+}
+
+// variantOf returns the variant of a built-in palette of a family, which is named after
+// the family followed by the variant ("Catppuccin Mocha"), or the family alone for the main variant.
+// The variant is returned in lower case.
+func variantOf(name, family string) (string, bool) {
+	switch {
+	case strings.EqualFold(name, family):
+		return "", true
+	case len(name) > len(family) && strings.EqualFold(name[:len(family)+1], family+" "):
+		return strings.ToLower(name[len(family)+1:]), true
+	default:
+		return "", false
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"charm.land/lipgloss/v2"
+	"github.com/dr8co/palettes/scheme"
 )
 
 // Color represents a single color with its definition and styling information.
@@ -11,31 +12,30 @@ type Color struct {
 	// Def is the color definition (name and hex).
 	Def ColorDefinition
 
+	// Value is the parsed color value of Def.Hex.
+	Value RGBA
+
 	// Style is the lipgloss style for the color.
 	Style lipgloss.Style
 }
 
-// ColorDefinition represents a color name and hex value pair.
-type ColorDefinition struct {
-	// Name is the name of the color.
-	Name string
-
-	// Hex is the hex code of the color.
-	Hex string
-}
+// ColorDefinition represents a color name and hex value pair. See [scheme.ColorDefinition].
+type ColorDefinition = scheme.ColorDefinition
 
 // NewColor creates a new Color instance.
-func NewColor(name, hex string) *Color {
-	return &Color{
-		Def:   ColorDefinition{Name: name, Hex: hex},
-		Style: lipgloss.NewStyle().Foreground(lipgloss.Color(hex)),
+// It returns an error wrapping [ErrInvalidHex] if hex is not a valid hex color.
+func NewColor(name, hex string) (*Color, error) {
+	value, err := ParseHex(hex)
+	if err != nil {
+		if name != "" {
+			return nil, fmt.Errorf("color %q: %w", name, err)
+		}
+		return nil, err
 	}
-}
 
-// String returns a string representation of a [ColorDefinition].
-func (cd *ColorDefinition) String() string {
-	if cd.Name == "" {
-		return cd.Hex
-	}
-	return fmt.Sprintf("%s (%s)", cd.Name, cd.Hex)
+	return &Color{
+		Def:   ColorDefinition{Name: name, Hex: hex},
+		Value: value,
+		Style: lipgloss.NewStyle().Foreground(value),
+	}, nil
 }
@@ -0,0 +1,318 @@
+package palette
+
+import "math"
+
+// D65 reference white in CIE XYZ, normalized so that Y = 1.
+const (
+	whiteX = 0.95047
+	whiteY = 1.0
+	whiteZ = 1.08883
+)
+
+// HSL represents a color in the HSL (hue, saturation, lightness) model.
+// H is in degrees [0, 360); S and L are in [0, 1].
+type HSL struct {
+	H, S, L float64
+}
+
+// HSV represents a color in the HSV (hue, saturation, value) model.
+// H is in degrees [0, 360); S and V are in [0, 1].
+type HSV struct {
+	H, S, V float64
+}
+
+// LinearRGB represents a color in linear-light sRGB, with channels in [0, 1].
+type LinearRGB struct {
+	R, G, B float64
+}
+
+// XYZ represents a color in the CIE 1931 XYZ color space relative to the D65 white point,
+// scaled so that the reference white has Y = 1.
+type XYZ struct {
+	X, Y, Z float64
+}
+
+// Lab represents a color in the CIE L*a*b* color space relative to the D65 white point.
+// L is in [0, 100].
+type Lab struct {
+	L, A, B float64
+}
+
+// OKLab represents a color in the OKLab perceptual color space.
+// L is in [0, 1].
+type OKLab struct {
+	L, A, B float64
+}
+
+// OKLCH represents a color in the cylindrical form of OKLab.
+// L is in [0, 1], C is the chroma and H is the hue in degrees [0, 360).
+type OKLCH struct {
+	L, C, H float64
+}
+
+// HSL converts the color to HSL.
+func (c RGBA) HSL() HSL {
+	r, g, b := c.floats()
+	maxC := max(r, g, b)
+	minC := min(r, g, b)
+	delta := maxC - minC
+
+	l := (maxC + minC) / 2
+	if delta == 0 {
+		return HSL{L: l}
+	}
+
+	s := delta / (1 - math.Abs(2*l-1))
+	return HSL{H: hue(r, g, b, maxC, delta), S: s, L: l}
+}
+
+// HSV converts the color to HSV.
+func (c RGBA) HSV() HSV {
+	r, g, b := c.floats()
+	maxC := max(r, g, b)
+	delta := maxC - min(r, g, b)
+
+	if delta == 0 {
+		return HSV{V: maxC}
+	}
+	return HSV{H: hue(r, g, b, maxC, delta), S: delta / maxC, V: maxC}
+}
+
+// Linear converts the color to linear-light sRGB.
+func (c RGBA) Linear() LinearRGB {
+	r, g, b := c.floats()
+	return LinearRGB{R: linearize(r), G: linearize(g), B: linearize(b)}
+}
+
+// XYZ converts the color to CIE XYZ.
+func (c RGBA) XYZ() XYZ {
+	return c.Linear().XYZ()
+}
+
+// Lab converts the color to CIE L*a*b*.
+func (c RGBA) Lab() Lab {
+	return c.XYZ().Lab()
+}
+
+// OKLab converts the color to OKLab.
+func (c RGBA) OKLab() OKLab {
+	return c.Linear().OKLab()
+}
+
+// OKLCH converts the color to OKLCH.
+func (c RGBA) OKLCH() OKLCH {
+	return c.OKLab().OKLCH()
+}
+
+// floats returns the color channels scaled to [0, 1].
+func (c RGBA) floats() (r, g, b float64) {
+	return float64(c.R) / 255, float64(c.G) / 255, float64(c.B) / 255
+}
+
+// ToRGBA converts the color to 8-bit sRGB. The result is fully opaque.
+func (h HSL) ToRGBA() RGBA {
+	chroma := (1 - math.Abs(2*h.L-1)) * h.S
+	return fromHueChroma(h.H, chroma, h.L-chroma/2)
+}
+
+// ToRGBA converts the color to 8-bit sRGB. The result is fully opaque.
+func (h HSV) ToRGBA() RGBA {
+	chroma := h.V * h.S
+	return fromHueChroma(h.H, chroma, h.V-chroma)
+}
+
+// ToRGBA converts the color to 8-bit sRGB, clipping out-of-gamut values.
+// The result is fully opaque.
+func (l LinearRGB) ToRGBA() RGBA {
+	return RGBA{
+		R: toByte(delinearize(l.R)),
+		G: toByte(delinearize(l.G)),
+		B: toByte(delinearize(l.B)),
+		A: 0xff,
+	}
+}
+
+// XYZ converts the color to CIE XYZ.
+func (l LinearRGB) XYZ() XYZ {
+	return XYZ{
+		X: 0.4124564*l.R + 0.3575761*l.G + 0.1804375*l.B,
+		Y: 0.2126729*l.R + 0.7151522*l.G + 0.0721750*l.B,
+		Z: 0.0193339*l.R + 0.1191920*l.G + 0.9503041*l.B,
+	}
+}
+
+// OKLab converts the color to OKLab.
+func (l LinearRGB) OKLab() OKLab {
+	lc := math.Cbrt(0.4122214708*l.R + 0.5363325363*l.G + 0.0514459929*l.B)
+	mc := math.Cbrt(0.2119034982*l.R + 0.6806995451*l.G + 0.1073969566*l.B)
+	sc := math.Cbrt(0.0883024619*l.R + 0.2817188376*l.G + 0.6299787005*l.B)
+
+	return OKLab{
+		L: 0.2104542553*lc + 0.7936177850*mc - 0.0040720468*sc,
+		A: 1.9779984951*lc - 2.4285922050*mc + 0.4505937099*sc,
+		B: 0.0259040371*lc + 0.7827717662*mc - 0.8086757660*sc,
+	}
+}
+
+// Linear converts the color to linear-light sRGB.
+func (x XYZ) Linear() LinearRGB {
+	return LinearRGB{
+		R: 3.2404542*x.X - 1.5371385*x.Y - 0.4985314*x.Z,
+		G: -0.9692660*x.X + 1.8760108*x.Y + 0.0415560*x.Z,
+		B: 0.0556434*x.X - 0.2040259*x.Y + 1.0572252*x.Z,
+	}
+}
+
+// Lab converts the color to CIE L*a*b*.
+func (x XYZ) Lab() Lab {
+	fx := labF(x.X / whiteX)
+	fy := labF(x.Y / whiteY)
+	fz := labF(x.Z / whiteZ)
+
+	return Lab{L: 116*fy - 16, A: 500 * (fx - fy), B: 200 * (fy - fz)}
+}
+
+// ToRGBA converts the color to 8-bit sRGB, clipping out-of-gamut values.
+func (x XYZ) ToRGBA() RGBA {
+	return x.Linear().ToRGBA()
+}
+
+// XYZ converts the color to CIE XYZ.
+func (l Lab) XYZ() XYZ {
+	fy := (l.L + 16) / 116
+	fx := fy + l.A/500
+	fz := fy - l.B/200
+
+	return XYZ{X: whiteX * labFInv(fx), Y: whiteY * labFInv(fy), Z: whiteZ * labFInv(fz)}
+}
+
+// ToRGBA converts the color to 8-bit sRGB, clipping out-of-gamut values.
+func (l Lab) ToRGBA() RGBA {
+	return l.XYZ().ToRGBA()
+}
+
+// Linear converts the color to linear-light sRGB.
+func (o OKLab) Linear() LinearRGB {
+	lc := o.L + 0.3963377774*o.A + 0.2158037573*o.B
+	mc := o.L - 0.1055613458*o.A - 0.0638541728*o.B
+	sc := o.L - 0.0894841775*o.A - 1.2914855480*o.B
+
+	lc, mc, sc = lc*lc*lc, mc*mc*mc, sc*sc*sc
+
+	return LinearRGB{
+		R: 4.0767416621*lc - 3.3077115913*mc + 0.2309699292*sc,
+		G: -1.2684380046*lc + 2.6097574011*mc - 0.3413193965*sc,
+		B: -0.0041960863*lc - 0.7034186147*mc + 1.7076147010*sc,
+	}
+}
+
+// OKLCH converts the color to its cylindrical OKLCH form.
+func (o OKLab) OKLCH() OKLCH {
+	h := math.Atan2(o.B, o.A) * 180 / math.Pi
+	if h < 0 {
+		h += 360
+	}
+	return OKLCH{L: o.L, C: math.Hypot(o.A, o.B), H: h}
+}
+
+// ToRGBA converts the color to 8-bit sRGB, clipping out-of-gamut values.
+func (o OKLab) ToRGBA() RGBA {
+	return o.Linear().ToRGBA()
+}
+
+// OKLab converts the color to its rectangular OKLab form.
+func (o OKLCH) OKLab() OKLab {
+	rad := o.H * math.Pi / 180
+	return OKLab{L: o.L, A: o.C * math.Cos(rad), B: o.C * math.Sin(rad)}
+}
+
+// ToRGBA converts the color to 8-bit sRGB, clipping out-of-gamut values.
+func (o OKLCH) ToRGBA() RGBA {
+	return o.OKLab().ToRGBA()
+}
+
+// hue computes the hue angle in degrees shared by the HSL and HSV models.
+func hue(r, g, b, maxC, delta float64) float64 {
+	var h float64
+	switch maxC {
+	case r:
+		h = math.Mod((g-b)/delta, 6)
+	case g:
+		h = (b-r)/delta + 2
+	default:
+		h = (r-g)/delta + 4
+	}
+
+	h *= 60
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
+
+// fromHueChroma builds an opaque color from a hue, a chroma and the amount to add to each channel.
+func fromHueChroma(h, chroma, m float64) RGBA {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+
+	x := chroma * (1 - math.Abs(math.Mod(h/60, 2)-1))
+
+	var r, g, b float64
+	switch {
+	case h < 60:
+		r, g, b = chroma, x, 0
+	case h < 120:
+		r, g, b = x, chroma, 0
+	case h < 180:
+		r, g, b = 0, chroma, x
+	case h < 240:
+		r, g, b = 0, x, chroma
+	case h < 300:
+		r, g, b = x, 0, chroma
+	default:
+		r, g, b = chroma, 0, x
+	}
+
+	return RGBA{R: toByte(r + m), G: toByte(g + m), B: toByte(b + m), A: 0xff}
+}
+
+// linearize applies the inverse sRGB transfer function.
+func linearize(v float64) float64 {
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+// delinearize applies the sRGB transfer function.
+func delinearize(v float64) float64 {
+	if v <= 0.0031308 {
+		return v * 12.92
+	}
+	return 1.055*math.Pow(v, 1/2.4) - 0.055
+}
+
+// labF is the nonlinear compression function of CIE L*a*b*.
+func labF(t float64) float64 {
+	const epsilon = 216.0 / 24389.0
+	if t > epsilon {
+		return math.Cbrt(t)
+	}
+	return (24389.0/27.0*t + 16) / 116
+}
+
+// labFInv is the inverse of labF.
+func labFInv(t float64) float64 {
+	const delta = 6.0 / 29.0
+	if t > delta {
+		return t * t * t
+	}
+	return 3 * delta * delta * (t - 4.0/29.0)
+}
+
+// toByte converts a channel in [0, 1] to an 8-bit value, clipping out-of-range input.
+func toByte(v float64) uint8 {
+	return uint8(math.Round(min(max(v, 0), 1) * 255))
+}
@@ -0,0 +1,161 @@
+package palette_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dr8co/palettes/palette"
+)
+
+// near reports whether the components of two colors differ by at most tolerance.
+// Hues are compared on the circle.
+func near(got, want [3]float64, tolerance float64, hueIndex int) bool {
+	for i := range got {
+		d := math.Abs(got[i] - want[i])
+		if i == hueIndex {
+			d = math.Min(d, 360-d)
+		}
+		if d > tolerance {
+			return false
+		}
+	}
+	return true
+}
+
+func TestColorSpaceReferences(t *testing.T) {
+	t.Parallel()
+
+	white := palette.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	red := palette.RGBA{R: 0xff, A: 0xff}
+	green := palette.RGBA{G: 0xff, A: 0xff}
+	blue := palette.RGBA{B: 0xff, A: 0xff}
+	gray := palette.RGBA{R: 0x80, G: 0x80, B: 0x80, A: 0xff}
+	dracula := palette.RGBA{R: 0xbd, G: 0x93, B: 0xf9, A: 0xff} // #bd93f9
+
+	// The XYZ, Lab and OKLab reference values are those of the CSS Color Module Level 4
+	// sample conversion code; the others follow from the definitions of the models.
+	tests := []struct {
+		space     string
+		color     palette.RGBA
+		got       func(palette.RGBA) [3]float64
+		want      [3]float64
+		tolerance float64
+		hueIndex  int // index of the hue component, or -1
+	}{
+		{"HSL", red, hsl, [3]float64{0, 1, 0.5}, 1e-9, 0},
+		{"HSL", gray, hsl, [3]float64{0, 0, 0x80 / 255.0}, 1e-9, 0},
+		{"HSL", dracula, hsl, [3]float64{264.7059, 0.894737, 0.776471}, 1e-4, 0},
+		{"HSV", green, hsv, [3]float64{120, 1, 1}, 1e-9, 0},
+		{"HSV", dracula, hsv, [3]float64{264.7059, 0.409639, 0.976471}, 1e-4, 0},
+		{"LinearRGB", gray, linear, [3]float64{0.2158605, 0.2158605, 0.2158605}, 1e-6, -1},
+		{"XYZ", white, xyz, [3]float64{0.95047, 1, 1.08883}, 1e-4, -1},
+		{"XYZ", red, xyz, [3]float64{0.4124564, 0.2126729, 0.0193339}, 1e-6, -1},
+		{"Lab", white, lab, [3]float64{100, 0, 0}, 1e-2, -1},
+		{"Lab", red, lab, [3]float64{53.2408, 80.0925, 67.2032}, 1e-2, -1},
+		{"Lab", blue, lab, [3]float64{32.2970, 79.1875, -107.8602}, 1e-2, -1},
+		{"OKLab", white, oklab, [3]float64{1, 0, 0}, 1e-4, -1},
+		{"OKLab", red, oklab, [3]float64{0.627955, 0.224863, 0.125846}, 1e-4, -1},
+		{"OKLab", green, oklab, [3]float64{0.866440, -0.233888, 0.179498}, 1e-4, -1},
+		{"OKLab", blue, oklab, [3]float64{0.452014, -0.032457, -0.311528}, 1e-4, -1},
+		{"OKLCH", red, oklch, [3]float64{0.627955, 0.257683, 29.2339}, 1e-3, 2},
+	}
+
+	for _, tt := range tests {
+		if got := tt.got(tt.color); !near(got, tt.want, tt.tolerance, tt.hueIndex) {
+			t.Errorf("%s of %s = %v, want %v", tt.space, tt.color.Hex(), got, tt.want)
+		}
+	}
+}
+
+// TestOKLabOttosson checks the OKLab conversion of the XYZ reference values published by
+// Björn Ottosson in "A perceptual color space for image processing" (2020).
+func TestOKLabOttosson(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		xyz  palette.XYZ
+		want [3]float64
+	}{
+		{palette.XYZ{X: 0.950, Y: 1.000, Z: 1.089}, [3]float64{1.000, 0.000, 0.000}},
+		{palette.XYZ{X: 1.000, Y: 0.000, Z: 0.000}, [3]float64{0.450, 1.236, -0.019}},
+		{palette.XYZ{X: 0.000, Y: 1.000, Z: 0.000}, [3]float64{0.922, -0.671, 0.263}},
+		{palette.XYZ{X: 0.000, Y: 0.000, Z: 1.000}, [3]float64{0.153, -1.415, -0.449}},
+	}
+
+	for _, tt := range tests {
+		o := tt.xyz.Linear().OKLab()
+		if got := [3]float64{o.L, o.A, o.B}; !near(got, tt.want, 2e-3, -1) {
+			t.Errorf("OKLab of %v = %v, want %v", tt.xyz, got, tt.want)
+		}
+	}
+}
+
+// TestColorSpaceRoundTrip converts colors of the sRGB cube to every color space and back.
+func TestColorSpaceRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	conversions := map[string]func(palette.RGBA) palette.RGBA{
+		"HSL":       func(c palette.RGBA) palette.RGBA { return c.HSL().ToRGBA() },
+		"HSV":       func(c palette.RGBA) palette.RGBA { return c.HSV().ToRGBA() },
+		"LinearRGB": func(c palette.RGBA) palette.RGBA { return c.Linear().ToRGBA() },
+		"XYZ":       func(c palette.RGBA) palette.RGBA { return c.XYZ().ToRGBA() },
+		"Lab":       func(c palette.RGBA) palette.RGBA { return c.Lab().ToRGBA() },
+		"Lab via XYZ": func(c palette.RGBA) palette.RGBA {
+			return c.Lab().XYZ().Linear().ToRGBA()
+		},
+		"OKLab": func(c palette.RGBA) palette.RGBA { return c.OKLab().ToRGBA() },
+		"OKLCH": func(c palette.RGBA) palette.RGBA { return c.OKLCH().ToRGBA() },
+		"OKLCH via OKLab": func(c palette.RGBA) palette.RGBA {
+			return c.OKLCH().OKLab().Linear().ToRGBA()
+		},
+	}
+
+	for space, convert := range conversions {
+		for r := 0; r < 256; r += 15 {
+			for g := 0; g < 256; g += 15 {
+				for b := 0; b < 256; b += 15 {
+					c := palette.RGBA{R: uint8(r), G: uint8(g), B: uint8(b), A: 0xff}
+					if got := convert(c); got != c {
+						t.Errorf("%s round trip of %s = %s", space, c.Hex(), got.Hex())
+					}
+				}
+			}
+		}
+	}
+}
+
+// hsl, hsv, linear, xyz, lab, oklab and oklch return the components of a color in a color space.
+func hsl(c palette.RGBA) [3]float64 {
+	v := c.HSL()
+	return [3]float64{v.H, v.S, v.L}
+}
+
+func hsv(c palette.RGBA) [3]float64 {
+	v := c.HSV()
+	return [3]float64{v.H, v.S, v.V}
+}
+
+func linear(c palette.RGBA) [3]float64 {
+	v := c.Linear()
+	return [3]float64{v.R, v.G, v.B}
+}
+
+func xyz(c palette.RGBA) [3]float64 {
+	v := c.XYZ()
+	return [3]float64{v.X, v.Y, v.Z}
+}
+
+func lab(c palette.RGBA) [3]float64 {
+	v := c.Lab()
+	return [3]float64{v.L, v.A, v.B}
+}
+
+func oklab(c palette.RGBA) [3]float64 {
+	v := c.OKLab()
+	return [3]float64{v.L, v.A, v.B}
+}
+
+func oklch(c palette.RGBA) [3]float64 {
+	v := c.OKLCH()
+	return [3]float64{v.L, v.C, v.H}
+}
@@ -0,0 +1,132 @@
+package palette
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MatchMode selects how the colors of compared palettes are paired by [Compare].
+type MatchMode int
+
+const (
+	// MatchRole pairs the colors assigned to the same semantic role.
+	MatchRole MatchMode = iota
+
+	// MatchNearest pairs each color of the reference palette with the perceptually
+	// nearest color ([DeltaE]) of each other palette.
+	MatchNearest
+)
+
+// String returns the name of the match mode, as accepted by [ParseMatchMode].
+func (m MatchMode) String() string {
+	if m == MatchNearest {
+		return "nearest"
+	}
+	return "role"
+}
+
+// ParseMatchMode converts a match mode name (case-insensitive) to a [MatchMode].
+func ParseMatchMode(name string) (MatchMode, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "role":
+		return MatchRole, nil
+	case "nearest", "color":
+		return MatchNearest, nil
+	default:
+		return MatchRole, fmt.Errorf("unsupported match mode '%s' (supported: role, nearest)", name)
+	}
+}
+
+// ColorMatch is a row of a palette comparison: the colors of the compared palettes paired together.
+type ColorMatch struct {
+	// Label identifies the row: the role, or the name of the color of the reference palette.
+	Label string
+
+	// Colors holds the color of each palette, in the order of the compared palettes.
+	Colors []Color
+
+	// Found reports whether each palette has a color for the row.
+	// Palettes without colors have none.
+	Found []bool
+
+	// DeltaE holds the difference between the color of each palette and the color of the reference palette.
+	// It is 0 for the reference palette itself, for the palettes that have no color for the row,
+	// and for all the palettes if the reference palette has none.
+	DeltaE []float64
+}
+
+// Compare pairs the colors of palettes with those of a reference palette (the first one), by semantic
+// role or by nearest color, and computes their differences. The rows are in canonical role order,
+// or in the order of the reference palette colors.
+func Compare(mode MatchMode, reference *Palette, others ...*Palette) []ColorMatch {
+	palettes := append([]*Palette{reference}, others...)
+
+	if mode == MatchNearest {
+		matches := make([]ColorMatch, 0, len(reference.colors))
+		for _, ref := range reference.colors {
+			match := newColorMatch(ref.Def.Name, len(palettes))
+			for i, p := range palettes {
+				if nearest, ok := p.nearest(ref.Value); ok {
+					match.set(i, nearest, ref)
+				}
+			}
+			matches = append(matches, match)
+		}
+		return matches
+	}
+
+	resolved := make([]map[Role]Color, len(palettes))
+	for i, p := range palettes {
+		resolved[i] = p.resolvedRoles()
+	}
+
+	var matches []ColorMatch
+	for _, role := range Roles() {
+		ref, hasRef := resolved[0][role]
+		match := newColorMatch(string(role), len(palettes))
+		found := false
+		for i := range palettes {
+			if color, ok := resolved[i][role]; ok {
+				found = true
+				if hasRef {
+					match.set(i, color, ref)
+				} else {
+					match.Colors[i], match.Found[i] = color, true
+				}
+			}
+		}
+		if found {
+			matches = append(matches, match)
+		}
+	}
+	return matches
+}
+
+// newColorMatch returns an empty row of a comparison of n palettes.
+func newColorMatch(label string, n int) ColorMatch {
+	return ColorMatch{
+		Label:  label,
+		Colors: make([]Color, n),
+		Found:  make([]bool, n),
+		DeltaE: make([]float64, n),
+	}
+}
+
+// set sets the color of the i-th palette, and its difference with the reference color.
+func (m *ColorMatch) set(i int, color, ref Color) {
+	m.Colors[i], m.Found[i] = color, true
+	m.DeltaE[i] = DeltaE(color.Value, ref.Value)
+}
+
+// nearest returns the color of the palette with the smallest difference to c.
+// The first color wins ties; it returns false for a palette without colors.
+func (p *Palette) nearest(c RGBA) (Color, bool) {
+	var best Color
+	bestDelta := -1.0
+	for _, color := range p.colors {
+		if d := DeltaE(color.Value, c); bestDelta < 0 || d < bestDelta {
+			best, bestDelta = color, d
+		}
+	}
+	return best, bestDelta >= 0
+}
@@ -0,0 +1,47 @@
+package palette_test
+
+import (
+	"testing"
+
+	"github.com/dr8co/palettes/palette"
+)
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	reference := palette.NewPalette("Reference").
+		AddColor("Background", "#000000").
+		AddColor("Foreground", "#ffffff").
+		AddColor("Red", "#ff0000")
+	other := palette.NewPalette("Other").
+		AddColor("Ink", "#111111").
+		AddColor("Paper", "#fefefe").
+		AddColor("Crimson", "#dc143c").
+		SetRoles(map[palette.Role]string{palette.RoleBackground: "Ink", palette.RoleForeground: "Paper"})
+
+	byRole := palette.Compare(palette.MatchRole, reference, other)
+	if len(byRole) != len(palette.Roles()) {
+		t.Fatalf("Compare by role returned %d rows, want one per role (%d)", len(byRole), len(palette.Roles()))
+	}
+	bg := byRole[0]
+	if bg.Label != "bg" || bg.Colors[0].Def.Name != "Background" || bg.Colors[1].Def.Name != "Ink" {
+		t.Errorf("background row = %s: %s, %s; want bg: Background, Ink", bg.Label, bg.Colors[0].Def.Name, bg.Colors[1].Def.Name)
+	}
+	if want := palette.DeltaE(bg.Colors[0].Value, bg.Colors[1].Value); bg.DeltaE[1] != want || bg.DeltaE[0] != 0 {
+		t.Errorf("background row ΔE = %v, want [0 %v]", bg.DeltaE, want)
+	}
+
+	// Each reference color is paired with the nearest color of the other palette
+	want := map[string]string{"Background": "Ink", "Foreground": "Paper", "Red": "Crimson"}
+	for _, match := range palette.Compare(palette.MatchNearest, reference, other) {
+		if got := match.Colors[1].Def.Name; got != want[match.Label] {
+			t.Errorf("nearest color to %s = %s, want %s", match.Label, got, want[match.Label])
+		}
+	}
+
+	// Palettes without colors have no match
+	empty := palette.Compare(palette.MatchNearest, reference, palette.NewPalette("Empty"))
+	if len(empty) != 3 || empty[0].Found[1] {
+		t.Errorf("Compare with an empty palette = %+v, want 3 rows without matches", empty)
+	}
+}
@@ -0,0 +1,120 @@
+package palette
+
+import "math"
+
+// ContrastLevel is the WCAG 2.x conformance level reached by a contrast ratio.
+type ContrastLevel int
+
+// WCAG 2.x contrast conformance levels, from lowest to highest.
+const (
+	// LevelFail means the ratio is below every WCAG threshold.
+	LevelFail ContrastLevel = iota
+
+	// LevelAALarge means the ratio passes AA for large text only (>= 3:1).
+	LevelAALarge
+
+	// LevelAA means the ratio passes AA for normal text (>= 4.5:1).
+	LevelAA
+
+	// LevelAAA means the ratio passes AAA for normal text (>= 7:1).
+	LevelAAA
+)
+
+// String returns the conventional badge label of the level.
+func (l ContrastLevel) String() string {
+	switch l {
+	case LevelAAA:
+		return "AAA"
+	case LevelAA:
+		return "AA"
+	case LevelAALarge:
+		return "AA18"
+	default:
+		return "FAIL"
+	}
+}
+
+// RelativeLuminance returns the WCAG 2.x relative luminance of the color, in [0, 1].
+func (c RGBA) RelativeLuminance() float64 {
+	l := c.Linear()
+	return 0.2126*l.R + 0.7152*l.G + 0.0722*l.B
+}
+
+// ContrastRatio returns the WCAG 2.x contrast ratio between two colors, in [1, 21].
+// The order of the arguments does not matter.
+func ContrastRatio(a, b RGBA) float64 {
+	la, lb := a.RelativeLuminance(), b.RelativeLuminance()
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// WCAGLevel returns the highest WCAG 2.x level reached by the given contrast ratio.
+func WCAGLevel(ratio float64) ContrastLevel {
+	switch {
+	case ratio >= 7:
+		return LevelAAA
+	case ratio >= 4.5:
+		return LevelAA
+	case ratio >= 3:
+		return LevelAALarge
+	default:
+		return LevelFail
+	}
+}
+
+// APCA constants (APCA-W3 0.0.98G-4g).
+const (
+	apcaNormBG     = 0.56
+	apcaNormText   = 0.57
+	apcaRevText    = 0.62
+	apcaRevBG      = 0.65
+	apcaBlackThr   = 0.022
+	apcaBlackClamp = 1.414
+	apcaScale      = 1.14
+	apcaLowOffset  = 0.027
+	apcaDeltaYMin  = 0.0005
+	apcaLowClip    = 0.1
+)
+
+// APCAContrast returns the APCA lightness contrast (Lc) of text on a background.
+//
+// Unlike [ContrastRatio], APCA is polarity-aware: dark text on a light background
+// yields a positive Lc, light text on a dark background a negative one.
+// Values range roughly from -108 to 106; an absolute value of 75 or more is
+// recommended for body text, and 60 for larger content text.
+func APCAContrast(text, background RGBA) float64 {
+	yText := apcaLuminance(text)
+	yBG := apcaLuminance(background)
+
+	if math.Abs(yBG-yText) < apcaDeltaYMin {
+		return 0
+	}
+
+	if yBG > yText {
+		// Dark text on a light background
+		sapc := (math.Pow(yBG, apcaNormBG) - math.Pow(yText, apcaNormText)) * apcaScale
+		if sapc < apcaLowClip {
+			return 0
+		}
+		return (sapc - apcaLowOffset) * 100
+	}
+
+	// Light text on a dark background
+	sapc := (math.Pow(yBG, apcaRevBG) - math.Pow(yText, apcaRevText)) * apcaScale
+	if sapc > -apcaLowClip {
+		return 0
+	}
+	return (sapc + apcaLowOffset) * 100
+}
+
+// apcaLuminance computes the APCA estimated screen luminance, with the soft black clamp applied.
+func apcaLuminance(c RGBA) float64 {
+	r, g, b := c.floats()
+	y := 0.2126729*math.Pow(r, 2.4) + 0.7151522*math.Pow(g, 2.4) + 0.0721750*math.Pow(b, 2.4)
+	if y < apcaBlackThr {
+		y += math.Pow(apcaBlackThr-y, apcaBlackClamp)
+	}
+	return y
+}
@@ -0,0 +1,121 @@
+package palette_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dr8co/palettes/palette"
+)
+
+// hexColor parses a hex color of a test table.
+func hexColor(t *testing.T, hex string) palette.RGBA {
+	t.Helper()
+
+	c, err := palette.ParseHex(hex)
+	if err != nil {
+		t.Fatalf("ParseHex(%s): %v", hex, err)
+	}
+	return c
+}
+
+func TestRelativeLuminance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		hex  string
+		want float64
+	}{
+		{"#000000", 0},
+		{"#ffffff", 1},
+		{"#ff0000", 0.2126},
+		{"#00ff00", 0.7152},
+		{"#0000ff", 0.0722},
+		{"#808080", 0.2158605},
+	}
+
+	for _, tt := range tests {
+		if got := hexColor(t, tt.hex).RelativeLuminance(); math.Abs(got-tt.want) > 1e-6 {
+			t.Errorf("RelativeLuminance(%s) = %v, want %v", tt.hex, got, tt.want)
+		}
+	}
+}
+
+func TestContrastRatio(t *testing.T) {
+	t.Parallel()
+
+	// The ratios of gray text on white are the usual references of WCAG checkers
+	tests := []struct {
+		a, b  string
+		want  float64
+		level palette.ContrastLevel
+	}{
+		{"#000000", "#ffffff", 21, palette.LevelAAA},
+		{"#ffffff", "#000000", 21, palette.LevelAAA},
+		{"#282a36", "#282a36", 1, palette.LevelFail},
+		{"#767676", "#ffffff", 4.54, palette.LevelAA},
+		{"#777777", "#ffffff", 4.48, palette.LevelAALarge},
+		{"#595959", "#ffffff", 7.00, palette.LevelAAA},
+		{"#959595", "#ffffff", 2.995, palette.LevelFail},
+	}
+
+	for _, tt := range tests {
+		got := palette.ContrastRatio(hexColor(t, tt.a), hexColor(t, tt.b))
+		if math.Abs(got-tt.want) > 0.005 {
+			t.Errorf("ContrastRatio(%s, %s) = %.4f, want %.2f", tt.a, tt.b, got, tt.want)
+		}
+		if level := palette.WCAGLevel(got); level != tt.level {
+			t.Errorf("WCAGLevel of %s on %s (%.4f) = %s, want %s", tt.a, tt.b, got, level, tt.level)
+		}
+	}
+}
+
+func TestWCAGLevel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		ratio float64
+		want  palette.ContrastLevel
+	}{
+		{1, palette.LevelFail},
+		{2.999, palette.LevelFail},
+		{3, palette.LevelAALarge},
+		{4.499, palette.LevelAALarge},
+		{4.5, palette.LevelAA},
+		{6.999, palette.LevelAA},
+		{7, palette.LevelAAA},
+		{21, palette.LevelAAA},
+	}
+
+	for _, tt := range tests {
+		if got := palette.WCAGLevel(tt.ratio); got != tt.want {
+			t.Errorf("WCAGLevel(%v) = %s, want %s", tt.ratio, got, tt.want)
+		}
+	}
+}
+
+func TestAPCAContrast(t *testing.T) {
+	t.Parallel()
+
+	// Reference values of the APCA-W3 0.0.98G-4g implementation
+	tests := []struct {
+		text, background string
+		want             float64
+	}{
+		{"#000000", "#ffffff", 106.04067},
+		{"#ffffff", "#000000", -107.88473},
+		{"#888888", "#ffffff", 63.05647},
+		{"#ffffff", "#888888", -68.54146},
+		{"#000000", "#aaaaaa", 58.14626},
+		{"#aaaaaa", "#000000", -56.24113},
+		{"#112233", "#ddeeff", 91.66831},
+		{"#ddeeff", "#112233", -93.06770},
+		{"#282a36", "#282a36", 0},
+	}
+
+	for _, tt := range tests {
+		got := palette.APCAContrast(hexColor(t, tt.text), hexColor(t, tt.background))
+		if math.Abs(got-tt.want) > 1e-3 {
+			t.Errorf("APCAContrast(%s on %s) = %.5f, want %.5f", tt.text, tt.background, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package palette
+
+import "math"
+
+// DeltaE returns the CIEDE2000 color difference between two colors.
+// A difference below 1 is not perceptible by the human eye, up to 2 is perceptible through
+// close observation, and above 10 the colors are more different than similar.
+// The order of the arguments does not matter.
+func DeltaE(a, b RGBA) float64 {
+	return a.Lab().DeltaE(b.Lab())
+}
+
+// DeltaE returns the CIEDE2000 difference between two CIE L*a*b* colors,
+// with the parametric weighting factors kL, kC and kH set to 1.
+// See Sharma, Wu and Dalal, "The CIEDE2000 Color-Difference Formula" (2005).
+func (l Lab) DeltaE(o Lab) float64 {
+	const pow25to7 = 6103515625 // 25^7
+
+	// Adjust the a* axis for the chroma of neutral colors
+	cBar := (math.Hypot(l.A, l.B) + math.Hypot(o.A, o.B)) / 2
+	cBar7 := math.Pow(cBar, 7)
+	g := 0.5 * (1 - math.Sqrt(cBar7/(cBar7+pow25to7)))
+
+	a1, a2 := (1+g)*l.A, (1+g)*o.A
+	c1, c2 := math.Hypot(a1, l.B), math.Hypot(a2, o.B)
+	h1, h2 := hueAngle(l.B, a1), hueAngle(o.B, a2)
+
+	// Differences in lightness, chroma and hue
+	dL := o.L - l.L
+	dC := c2 - c1
+
+	var dh float64
+	if c1*c2 != 0 {
+		dh = h2 - h1
+		switch {
+		case dh > 180:
+			dh -= 360
+		case dh < -180:
+			dh += 360
+		}
+	}
+	dH := 2 * math.Sqrt(c1*c2) * math.Sin(radians(dh/2))
+
+	// Means of lightness, chroma and hue
+	lBar := (l.L + o.L) / 2
+	cBarP := (c1 + c2) / 2
+
+	hBar := h1 + h2
+	if c1*c2 != 0 {
+		switch {
+		case math.Abs(h1-h2) <= 180:
+			hBar /= 2
+		case hBar < 360:
+			hBar = (hBar + 360) / 2
+		default:
+			hBar = (hBar - 360) / 2
+		}
+	}
+
+	// Weighting functions and rotation term
+	t := 1 - 0.17*math.Cos(radians(hBar-30)) +
+		0.24*math.Cos(radians(2*hBar)) +
+		0.32*math.Cos(radians(3*hBar+6)) -
+		0.20*math.Cos(radians(4*hBar-63))
+
+	l50 := (lBar - 50) * (lBar - 50)
+	sL := 1 + 0.015*l50/math.Sqrt(20+l50)
+	sC := 1 + 0.045*cBarP
+	sH := 1 + 0.015*cBarP*t
+
+	cBarP7 := math.Pow(cBarP, 7)
+	rC := 2 * math.Sqrt(cBarP7/(cBarP7+pow25to7))
+	dTheta := 30 * math.Exp(-((hBar-275)/25)*((hBar-275)/25))
+	rT := -math.Sin(radians(2*dTheta)) * rC
+
+	wL, wC, wH := dL/sL, dC/sC, dH/sH
+	return math.Sqrt(wL*wL + wC*wC + wH*wH + rT*wC*wH)
+}
+
+// hueAngle returns the hue angle in degrees [0, 360) of the given b and a coordinates.
+func hueAngle(b, a float64) float64 {
+	if a == 0 && b == 0 {
+		return 0
+	}
+	h := math.Atan2(b, a) * 180 / math.Pi
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
+
+// radians converts an angle from degrees to radians.
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
@@ -0,0 +1,49 @@
+package palette_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dr8co/palettes/palette"
+)
+
+func TestLabDeltaE(t *testing.T) {
+	t.Parallel()
+
+	// Test data from Sharma, Wu and Dalal, "The CIEDE2000 Color-Difference Formula" (2005)
+	tests := []struct {
+		a, b palette.Lab
+		want float64
+	}{
+		{palette.Lab{L: 50, A: 2.6772, B: -79.7751}, palette.Lab{L: 50, A: 0, B: -82.7485}, 2.0425},
+		{palette.Lab{L: 50, A: 0, B: 0}, palette.Lab{L: 50, A: -1, B: 2}, 2.3669},
+		{palette.Lab{L: 50, A: 2.49, B: -0.001}, palette.Lab{L: 50, A: -2.49, B: 0.0009}, 7.1792},
+		{palette.Lab{L: 50, A: 2.49, B: -0.001}, palette.Lab{L: 50, A: -2.49, B: 0.0011}, 7.2195},
+		{palette.Lab{L: 50, A: 2.5, B: 0}, palette.Lab{L: 73, A: 25, B: -18}, 27.1492},
+		{palette.Lab{L: 50, A: 2.5, B: 0}, palette.Lab{L: 61, A: -5, B: 29}, 22.8977},
+		{palette.Lab{L: 60.2574, A: -34.0099, B: 36.2677}, palette.Lab{L: 60.4626, A: -34.1751, B: 39.4387}, 1.2644},
+		{palette.Lab{L: 22.7233, A: 20.0904, B: -46.694}, palette.Lab{L: 23.0331, A: 14.973, B: -42.5619}, 2.0373},
+	}
+
+	for _, tt := range tests {
+		for _, pair := range [][2]palette.Lab{{tt.a, tt.b}, {tt.b, tt.a}} {
+			if got := pair[0].DeltaE(pair[1]); math.Abs(got-tt.want) > 5e-5 {
+				t.Errorf("%v.DeltaE(%v) = %.4f, want %.4f", pair[0], pair[1], got, tt.want)
+			}
+		}
+	}
+}
+
+func TestDeltaE(t *testing.T) {
+	t.Parallel()
+
+	black := palette.RGBA{A: 0xff}
+	white := palette.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+
+	if got := palette.DeltaE(white, white); got != 0 {
+		t.Errorf("DeltaE(white, white) = %v, want 0", got)
+	}
+	if got := palette.DeltaE(black, white); math.Abs(got-100) > 1e-3 {
+		t.Errorf("DeltaE(black, white) = %v, want 100", got)
+	}
+}
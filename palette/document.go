@@ -0,0 +1,41 @@
+package palette
+
+// Document is the serializable representation of a palette.
+// It is the data model shared by the machine-readable exporters.
+type Document struct {
+	// Name is the name of the palette.
+	Name string `json:"name" yaml:"name" toml:"name"`
+
+	// Metadata holds the attribution details of the palette, inlined in the document.
+	Metadata `yaml:",inline"`
+
+	// Families are the families the palette belongs to.
+	Families []string `json:"families,omitempty" yaml:"families,omitempty" toml:"families,omitempty"`
+
+	// Colors are the color definitions, in palette order.
+	Colors []ColorDefinition `json:"colors" yaml:"colors" toml:"colors"`
+
+	// Roles maps semantic roles to color references (a color name or a hex value).
+	// Only explicitly assigned roles are included.
+	Roles map[Role]string `json:"roles,omitempty" yaml:"roles,omitempty" toml:"roles,omitempty"`
+}
+
+// Document returns the serializable representation of the palette.
+func (p *Palette) Document() Document {
+	doc := Document{
+		Name:     p.name,
+		Metadata: p.meta,
+		Families: append([]string(nil), p.families...),
+		Colors:   make([]ColorDefinition, 0, len(p.colors)),
+	}
+
+	doc.Colors = append(doc.Colors, p.Definitions()...)
+
+	if len(p.roles) > 0 {
+		doc.Roles = make(map[Role]string, len(p.roles))
+		for role, ref := range p.roles {
+			doc.Roles[role] = ref
+		}
+	}
+	return doc
+}
@@ -1,92 +0,0 @@
-package palette
-
-import "strings"
-
-// GruvboxColors contains the color definitions for the classic Gruvbox themes.
-// Check https://github.com/morhetz/gruvbox for more information.
-var GruvboxColors = map[string][]ColorDefinition{
-	"dark": {
-		{"bg", "#282828"},
-		{"red", "#cc241d"},
-		{"green", "#98971a"},
-		{"yellow", "#d79921"},
-		{"blue", "#458588"},
-		{"purple", "#b16286"},
-		{"aqua", "#689d6a"},
-		{"gray", "#a89984"},
-		{"gray", "#928374"},
-		{"red", "#fb4934"},
-		{"green", "#b8bb26"},
-		{"yellow", "#fabd2f"},
-		{"blue", "#83a598"},
-		{"purple", "#d3869b"},
-		{"aqua", "#8ec07c"},
-		{"fg", "#ebdbb2"},
-		{"bg0_h", "#1d2021"},
-		{"bg0", "#282828"},
-		{"bg1", "#3c3836"},
-		{"bg2", "#504945"},
-		{"bg3", "#665c54"},
-		{"bg4", "#7c6f64"},
-		{"gray", "#928374"},
-		{"orange", "#d65d0e"},
-		{"bg0_s", "#32302f"},
-		{"fg4", "#a89984"},
-		{"fg3", "#bdae93"},
-		{"fg2", "#d5c4a1"},
-		{"fg1", "#ebdbb2"},
-		{"fg0", "#fbf1c7"},
-		{"orange", "#fe8019"},
-	},
-	"light": {
-		{"bg", "#fbf1c7"},
-		{"red", "#cc241d"},
-		{"green", "#98971a"},
-		{"yellow", "#d79921"},
-		{"blue", "#458588"},
-		{"purple", "#b16286"},
-		{"aqua", "#689d6a"},
-		{"gray", "#7c6f64"},
-		{"gray", "#928374"},
-		{"red", "#9d0006"},
-		{"green", "#79740e"},
-		{"yellow", "#b57614"},
-		{"blue", "#076678"},
-		{"purple", "#8f3f71"},
-		{"aqua", "#427b58"},
-		{"fg", "#3c3836"},
-		{"bg0_h", "#f9f5d7"},
-		{"bg0", "#fbf1c7"},
-		{"bg1", "#ebdbb2"},
-		{"bg2", "#d5c4a1"},
-		{"bg3", "#bdae93"},
-		{"bg4", "#a89984"},
-		{"gray", "#928374"},
-		{"orange", "#d65d0e"},
-		{"bg0_s", "#f2e5bc"},
-		{"fg4", "#7c6f64"},
-		{"fg3", "#665c54"},
-		{"fg2", "#504945"},
-		{"fg1", "#3c3836"},
-		{"fg0", "#282828"},
-		{"orange", "#af3a03"},
-	},
-}
-
-// CreateGruvboxPalette creates a Gruvbox palette with appropriate families.
-func CreateGruvboxPalette(variant string) *Palette {
-	variant = strings.ToLower(strings.TrimSpace(variant))
-	colors, exists := GruvboxColors[variant]
-	if !exists {
-		return nil
-	}
-
-	name := "Gruvbox " + variant
-
-	palette := NewPalette(name, "gruvbox", "pastel", "retro", "groove", variant)
-
-	for _, color := range colors {
-		palette.AddColor(color.Name, color.Hex)
-	}
-	return palette
-}
@@ -0,0 +1,204 @@
+package palette
+
+import (
+	"math"
+	"strings"
+)
+
+// ansiHues holds the reference OKLCH hue (in degrees) of the chromatic ANSI colors,
+// indexed by ANSI slot. Black and white are matched by lightness instead.
+var ansiHues = [8]float64{1: 29, 2: 142, 3: 100, 4: 264, 5: 328, 6: 195}
+
+// minChroma is the OKLCH chroma below which a color is considered neutral (a gray).
+const minChroma = 0.05
+
+// inferRoles fills the roles missing from resolved with colors inferred from the palette.
+//
+// Background and foreground colors are looked up by name first ("background", "bg",
+// "foreground", "text", ...) and otherwise chosen by lightness. ANSI colors are assigned
+// by matching the hue of each palette color to the reference hue of each slot.
+// The palette must have at least one color.
+func inferRoles(p *Palette, resolved map[Role]Color) {
+	colors := p.colors
+	set := func(role Role, infer func() Color) {
+		if _, ok := resolved[role]; !ok {
+			resolved[role] = infer()
+		}
+	}
+
+	set(RoleBackground, func() Color {
+		if c, ok := findByName(colors, "background", "bg", "bg0", "base"); ok {
+			return c
+		}
+		return extreme(colors, p.HasFamily("light"))
+	})
+	bg := resolved[RoleBackground]
+
+	set(RoleForeground, func() Color {
+		if c, ok := findByName(colors, "foreground", "fg", "text"); ok {
+			return c
+		}
+		return highestContrast(colors, bg)
+	})
+	fg := resolved[RoleForeground]
+
+	set(RoleCursor, func() Color { return fg })
+	set(RoleSelection, func() Color {
+		if c, ok := findByName(colors, "selection"); ok {
+			return c
+		}
+		return nearest(colors, bg)
+	})
+
+	// Black and white are the neutral colors closest to the background and foreground;
+	// their bright variants sit in between.
+	neutrals := make([]Color, 0, len(colors))
+	for _, c := range colors {
+		if c.Value.OKLCH().C < minChroma {
+			neutrals = append(neutrals, c)
+		}
+	}
+	if len(neutrals) == 0 {
+		neutrals = []Color{bg, fg}
+	}
+
+	bgL, fgL := bg.Value.OKLab().L, fg.Value.OKLab().L
+	set(RoleBlack, func() Color { return closestLightness(neutrals, bgL+(fgL-bgL)*0.15) })
+	set(RoleBrightBlack, func() Color { return closestLightness(neutrals, bgL+(fgL-bgL)*0.45) })
+	set(RoleWhite, func() Color { return closestLightness(neutrals, bgL+(fgL-bgL)*0.8) })
+	set(RoleBrightWhite, func() Color { return closestLightness(neutrals, fgL) })
+
+	for slot := 1; slot <= 6; slot++ {
+		normal, bright := closestHue(colors, ansiHues[slot], fg)
+		set(ANSIRoles[slot], func() Color { return normal })
+		set(ANSIRoles[slot+8], func() Color { return bright })
+	}
+
+	set(RoleAccent, func() Color { return mostChromatic(colors, fg) })
+	set(RoleError, func() Color { return resolved[RoleRed] })
+	set(RoleWarning, func() Color { return resolved[RoleYellow] })
+	set(RoleInfo, func() Color { return resolved[RoleBlue] })
+}
+
+// findByName returns the first color whose name equals one of the given names (case-insensitive).
+func findByName(colors []Color, names ...string) (Color, bool) {
+	for _, name := range names {
+		for _, c := range colors {
+			if strings.EqualFold(c.Def.Name, name) {
+				return c, true
+			}
+		}
+	}
+	return Color{}, false
+}
+
+// extreme returns the darkest color, or the lightest one if lightest is true.
+func extreme(colors []Color, lightest bool) Color {
+	best := colors[0]
+	for _, c := range colors[1:] {
+		l, bestL := c.Value.RelativeLuminance(), best.Value.RelativeLuminance()
+		if (lightest && l > bestL) || (!lightest && l < bestL) {
+			best = c
+		}
+	}
+	return best
+}
+
+// highestContrast returns the color with the highest contrast ratio against bg.
+func highestContrast(colors []Color, bg Color) Color {
+	best, bestRatio := colors[0], 0.0
+	for _, c := range colors {
+		if ratio := ContrastRatio(c.Value, bg.Value); ratio > bestRatio {
+			best, bestRatio = c, ratio
+		}
+	}
+	return best
+}
+
+// nearest returns the color perceptually closest to target, excluding colors of the same value.
+func nearest(colors []Color, target Color) Color {
+	best, bestDist := target, math.Inf(1)
+	for _, c := range colors {
+		if c.Value == target.Value {
+			continue
+		}
+		if dist := okDistance(c.Value, target.Value); dist < bestDist {
+			best, bestDist = c, dist
+		}
+	}
+	return best
+}
+
+// closestLightness returns the color whose OKLab lightness is closest to l.
+func closestLightness(colors []Color, l float64) Color {
+	best, bestDiff := colors[0], math.Inf(1)
+	for _, c := range colors {
+		if diff := math.Abs(c.Value.OKLab().L - l); diff < bestDiff {
+			best, bestDiff = c, diff
+		}
+	}
+	return best
+}
+
+// closestHue returns the two chromatic colors closest in hue to the given reference hue,
+// ordered so that the darker one comes first. If only one candidate exists it is returned twice,
+// and if the palette has no chromatic colors at all, fallback is returned for both.
+func closestHue(colors []Color, h float64, fallback Color) (normal, bright Color) {
+	first, second := -1, -1
+	firstDiff, secondDiff := math.Inf(1), math.Inf(1)
+
+	for i, c := range colors {
+		lch := c.Value.OKLCH()
+		if lch.C < minChroma {
+			continue
+		}
+
+		diff := hueDistance(lch.H, h)
+		switch {
+		case diff < firstDiff:
+			second, secondDiff = first, firstDiff
+			first, firstDiff = i, diff
+		case diff < secondDiff && c.Value != colors[first].Value:
+			second, secondDiff = i, diff
+		}
+	}
+
+	switch {
+	case first < 0:
+		return fallback, fallback
+	case second < 0 || secondDiff > firstDiff+20:
+		return colors[first], colors[first]
+	}
+
+	normal, bright = colors[first], colors[second]
+	if normal.Value.OKLab().L > bright.Value.OKLab().L {
+		normal, bright = bright, normal
+	}
+	return normal, bright
+}
+
+// mostChromatic returns the color with the highest OKLCH chroma, or fallback if all colors are neutral.
+func mostChromatic(colors []Color, fallback Color) Color {
+	best, bestChroma := fallback, minChroma
+	for _, c := range colors {
+		if chroma := c.Value.OKLCH().C; chroma > bestChroma {
+			best, bestChroma = c, chroma
+		}
+	}
+	return best
+}
+
+// hueDistance returns the angular distance between two hues, in degrees [0, 180].
+func hueDistance(a, b float64) float64 {
+	d := math.Mod(math.Abs(a-b), 360)
+	if d > 180 {
+		d = 360 - d
+	}
+	return d
+}
+
+// okDistance returns the Euclidean distance between two colors in OKLab.
+func okDistance(a, b RGBA) float64 {
+	la, lb := a.OKLab(), b.OKLab()
+	return math.Sqrt((la.L-lb.L)*(la.L-lb.L) + (la.A-lb.A)*(la.A-lb.A) + (la.B-lb.B)*(la.B-lb.B))
+}
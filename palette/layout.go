@@ -0,0 +1,311 @@
+package palette
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/table"
+	"github.com/charmbracelet/colorprofile"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/term"
+)
+
+// DefaultWidth is the width, in columns, of the rendered palettes when the terminal width is unknown.
+const DefaultWidth = 80
+
+// Layout selects how a palette is rendered.
+type Layout int
+
+const (
+	// LayoutText shows each color as sample text in various styles, next to a bar with its name and hex value.
+	LayoutText Layout = iota
+
+	// LayoutStrip shows the colors as a compact strip of swatches.
+	LayoutStrip
+
+	// LayoutGrid shows the colors as a grid of blocks labeled with their names and hex values.
+	LayoutGrid
+
+	// LayoutTable shows the colors in a table, with their hex, RGB and HSL values.
+	LayoutTable
+
+	// LayoutSample shows a sample terminal session (syntax-highlighted code, git diff, ls --color
+	// listing and shell prompts) colored with the semantic roles of the palette.
+	LayoutSample
+)
+
+// layoutNames holds the names of the layouts, as accepted by [ParseLayout].
+var layoutNames = map[Layout]string{
+	LayoutText:   "text",
+	LayoutStrip:  "strip",
+	LayoutGrid:   "grid",
+	LayoutTable:  "table",
+	LayoutSample: "sample",
+}
+
+// Layouts returns all layouts, in display order.
+func Layouts() []Layout {
+	return []Layout{LayoutText, LayoutStrip, LayoutGrid, LayoutTable, LayoutSample}
+}
+
+// String returns the name of the layout.
+func (l Layout) String() string {
+	return layoutNames[l]
+}
+
+// ParseLayout converts a layout name (case-insensitive) to a [Layout].
+func ParseLayout(name string) (Layout, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	names := make([]string, 0, len(layoutNames))
+	for _, layout := range Layouts() {
+		if layout.String() == name {
+			return layout, nil
+		}
+		names = append(names, layout.String())
+	}
+	return LayoutText, fmt.Errorf("unsupported layout '%s' (supported: %s)", name, strings.Join(names, ", "))
+}
+
+// TerminalWidth returns the width of the terminal of the standard output,
+// or [DefaultWidth] if the standard output is not a terminal.
+func TerminalWidth() int {
+	width, _, err := term.GetSize(os.Stdout.Fd())
+	if err != nil || width <= 0 {
+		return DefaultWidth
+	}
+	return width
+}
+
+// LayoutView renders a palette with a [Layout], fitted to a width.
+//
+// A LayoutView implements [ColorScheme], so views can be registered and shown like palettes.
+type LayoutView struct {
+	*Palette
+
+	// Layout is the layout of the rendering.
+	Layout Layout
+
+	// Width is the maximum width of the rendering, in columns. It defaults to [DefaultWidth].
+	Width int
+}
+
+// WithLayout returns a view rendering the palette with a layout, fitted to a width.
+func (p *Palette) WithLayout(layout Layout, width int) *LayoutView {
+	return &LayoutView{Palette: p, Layout: layout, Width: width}
+}
+
+// Render returns the styled rendering of the view, as displayed by [LayoutView.Show].
+func (v *LayoutView) Render() string {
+	return v.RenderLayout(v.Layout, v.Width)
+}
+
+// WriteTo writes the rendering of the view to w.
+// It implements the [io.WriterTo] interface.
+func (v *LayoutView) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, v.Render())
+	if err != nil {
+		return int64(n), fmt.Errorf("writing palette %s: %w", v.name, err)
+	}
+	return int64(n), nil
+}
+
+// Show displays the view on the standard output.
+func (v *LayoutView) Show() {
+	_, _ = v.WriteTo(colorprofile.NewWriter(os.Stdout, os.Environ()))
+}
+
+// RenderLayout returns the styled rendering of the palette with a layout, fitted to a width
+// in columns ([DefaultWidth] if width is not positive). [Palette.Render] uses the text layout.
+func (p *Palette) RenderLayout(layout Layout, width int) string {
+	if width <= 0 {
+		width = DefaultWidth
+	}
+
+	var b strings.Builder
+	title := lipgloss.NewStyle().Bold(true).Render(titleCaser.String(p.name))
+	b.WriteString("Palette: " + title + "\n")
+
+	switch layout {
+	case LayoutStrip:
+		p.renderStrip(&b, width)
+	case LayoutGrid:
+		p.renderGrid(&b, width)
+	case LayoutTable:
+		p.renderTable(&b, width)
+	case LayoutSample:
+		p.renderSample(&b, width)
+	default:
+		p.renderText(&b, width)
+	}
+	b.WriteString(strings.Repeat("─", width) + "\n\n")
+
+	return fitLines(b.String(), width)
+}
+
+// fitLines truncates the lines of a rendering wider than width.
+func fitLines(s string, width int) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = ansi.Truncate(line, width, "")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderText renders each color as text in various styles, followed by a bar with the
+// color name and hex value that fills the remaining width.
+//
+// When the width is too small for all of them, the trailing styled-text samples are dropped,
+// and below the width of the bar alone, the bar is shortened.
+func (p *Palette) renderText(b *strings.Builder, width int) {
+	const minBarWidth = 36 // the width of the name and hex value, with their margins
+
+	// Keep as many samples (separated by a space, and by two from the bar) as fit
+	samples, samplesWidth := len(placeHolderText), 0
+	for ; samples > 0; samples-- {
+		samplesWidth = len(strings.Join(placeHolderText[:samples], " ")) + 2
+		if samplesWidth+minBarWidth <= width {
+			break
+		}
+	}
+	if samples == 0 {
+		samplesWidth = 0
+	}
+	barWidth := width - samplesWidth
+
+	for _, color := range p.colors {
+		style := color.Style
+		texts := []string{
+			style.Render(placeHolderText[0]),
+			style.Italic(true).Render(placeHolderText[1]),
+			style.Bold(true).Render(placeHolderText[2]),
+			style.Underline(true).UnderlineSpaces(true).Render(placeHolderText[3]),
+			style.Strikethrough(true).Render(placeHolderText[4]),
+		}
+
+		label := []rune(fmt.Sprintf(" %-20s %-13s", titleCaser.String(color.Def.Name), color.Def.Hex))
+		if len(label) > barWidth {
+			label = label[:barWidth]
+		}
+		bar := style.Reverse(true).Render(string(label) + strings.Repeat(" ", barWidth-len(label)))
+
+		if samples > 0 {
+			b.WriteString(strings.Join(texts[:samples], " ") + "  ")
+		}
+		b.WriteString(bar + "\n")
+	}
+}
+
+// renderStrip renders the colors as a strip of swatches, wrapped to the width.
+// Swatches share the width, from 2 to 8 columns each.
+func (p *Palette) renderStrip(b *strings.Builder, width int) {
+	if len(p.colors) == 0 {
+		return
+	}
+
+	swatchWidth := min(max(width/len(p.colors), 2), 8)
+	perLine := max(width/swatchWidth, 1)
+	swatch := strings.Repeat(" ", swatchWidth)
+
+	for start := 0; start < len(p.colors); start += perLine {
+		var line strings.Builder
+		for _, color := range p.colors[start:min(start+perLine, len(p.colors))] {
+			line.WriteString(lipgloss.NewStyle().Background(color.Value).Render(swatch))
+		}
+		// Two rows of the same swatches make them easier to see
+		b.WriteString(line.String() + "\n" + line.String() + "\n")
+	}
+}
+
+// renderGrid renders the colors as blocks labeled with their names and hex values,
+// with as many columns as fit in the width.
+func (p *Palette) renderGrid(b *strings.Builder, width int) {
+	const blockWidth = 18
+	columns := max((width+1)/(blockWidth+1), 1)
+
+	var rows []string
+	for start := 0; start < len(p.colors); start += columns {
+		blocks := make([]string, 0, columns*2)
+		for i, color := range p.colors[start:min(start+columns, len(p.colors))] {
+			if i > 0 {
+				blocks = append(blocks, " ")
+			}
+			block := lipgloss.NewStyle().
+				Width(blockWidth).
+				Padding(0, 1).
+				Background(color.Value).
+				Foreground(labelColor(color.Value))
+			blocks = append(blocks, block.Render(truncate(titleCaser.String(color.Def.Name), blockWidth-2)+"\n"+color.Def.Hex))
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, blocks...))
+	}
+	if len(rows) > 0 {
+		b.WriteString(strings.Join(rows, "\n\n") + "\n")
+	}
+}
+
+// renderTable renders the colors in a table with their hex, RGB and HSL values.
+// The RGB and HSL columns are dropped if the table does not fit in the width, and the names
+// are wrapped if it still does not.
+func (p *Palette) renderTable(b *strings.Builder, width int) {
+	headers := []string{"", "Name", "Hex", "RGB", "HSL"}
+	rows := make([][]string, 0, len(p.colors))
+	for _, color := range p.colors {
+		c, hsl := color.Value, color.Value.HSL()
+		rows = append(rows, []string{
+			lipgloss.NewStyle().Background(c).Render("    "),
+			titleCaser.String(color.Def.Name),
+			color.Def.Hex,
+			fmt.Sprintf("%3d, %3d, %3d", c.R, c.G, c.B),
+			fmt.Sprintf("%3.0f°, %3.0f%%, %3.0f%%", math.Round(hsl.H), hsl.S*100, hsl.L*100),
+		})
+	}
+
+	var rendered string
+	for columns := len(headers); columns >= 3; columns-- {
+		t := table.New().
+			Border(lipgloss.RoundedBorder()).
+			Headers(headers[:columns]...).
+			StyleFunc(func(row, _ int) lipgloss.Style {
+				if row == table.HeaderRow {
+					return lipgloss.NewStyle().Bold(true).Padding(0, 1)
+				}
+				return lipgloss.NewStyle().Padding(0, 1)
+			})
+		for _, row := range rows {
+			t.Row(row[:columns]...)
+		}
+
+		rendered = t.Render()
+		if lipgloss.Width(rendered) <= width {
+			break
+		}
+		if columns == 3 {
+			// Even the name and hex columns are too wide: wrap the names
+			rendered = t.Width(width).Render()
+		}
+	}
+	b.WriteString(rendered + "\n")
+}
+
+// labelColor returns black or white, whichever contrasts most with a background color.
+func labelColor(background RGBA) RGBA {
+	black := RGBA{A: 0xff}
+	white := RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	if ContrastRatio(black, background) >= ContrastRatio(white, background) {
+		return black
+	}
+	return white
+}
+
+// truncate shortens a string to at most width runes, ending it with an ellipsis if it was cut.
+func truncate(s string, width int) string {
+	runes := []rune(s)
+	if len(runes) <= width {
+		return s
+	}
+	return string(runes[:width-1]) + "…"
+}
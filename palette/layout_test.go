@@ -0,0 +1,38 @@
+package palette_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/dr8co/palettes/palette"
+)
+
+// TestRenderLayoutWidth checks that every layout of every bundled palette fits in the requested width.
+func TestRenderLayoutWidth(t *testing.T) {
+	t.Parallel()
+
+	reg := palette.Default()
+	for _, layout := range palette.Layouts() {
+		for _, width := range []int{30, 40, 60, 80, 120} {
+			t.Run(fmt.Sprintf("%s-%d", layout, width), func(t *testing.T) {
+				t.Parallel()
+
+				for _, name := range reg.List() {
+					scheme, _ := reg.Get(name)
+					p, ok := scheme.(*palette.Palette)
+					if !ok {
+						continue
+					}
+
+					for i, line := range strings.Split(p.RenderLayout(layout, width), "\n") {
+						if got := ansi.StringWidth(line); got > width {
+							t.Errorf("%s: line %d is %d columns wide, want at most %d: %q", name, i+1, got, width, ansi.Strip(line))
+						}
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package palette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"go.yaml.in/yaml/v3"
+)
+
+// ErrUnsupportedFile is returned when a palette file has an unrecognized extension.
+var ErrUnsupportedFile = errors.New("unsupported palette file type")
+
+// decoders maps the supported file extensions to their document decoder.
+var decoders = map[string]func(data []byte, doc *Document) error{
+	".json": decodeJSON,
+	".yaml": decodeYAML,
+	".yml":  decodeYAML,
+	".toml": decodeTOML,
+}
+
+// IsPaletteFile reports whether a file name has the extension of a supported
+// palette definition format (JSON, YAML or TOML).
+func IsPaletteFile(name string) bool {
+	_, ok := decoders[strings.ToLower(filepath.Ext(name))]
+	return ok
+}
+
+// LoadFile loads a palette from a definition file.
+// The format (JSON, YAML or TOML) is chosen by the file extension.
+func LoadFile(path string) (*Palette, error) {
+	if !IsPaletteFile(path) {
+		return nil, fmt.Errorf("%s: %w (expected .json, .yaml, .yml or .toml)", path, ErrUnsupportedFile)
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec // loading user-selected files is the purpose of this function
+	if err != nil {
+		return nil, fmt.Errorf("reading palette file: %w", err)
+	}
+	return decodeFile(path, data)
+}
+
+// LoadDir loads all palette definition files in a directory, in file name order.
+// Subdirectories and files with unsupported extensions are ignored.
+// Every file is attempted; the errors of all malformed files are joined.
+func LoadDir(dir string) ([]*Palette, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading palette directory: %w", err)
+	}
+	return loadEntries(entries, func(name string) (*Palette, error) {
+		return LoadFile(filepath.Join(dir, name))
+	})
+}
+
+// LoadFS loads all palette definition files in a directory of a file system, such as
+// an [embed.FS], in file name order. See [LoadDir].
+func LoadFS(fsys fs.FS, dir string) ([]*Palette, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading palette directory: %w", err)
+	}
+	return loadEntries(entries, func(name string) (*Palette, error) {
+		name = path.Join(dir, name)
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, fmt.Errorf("reading palette file: %w", err)
+		}
+		return decodeFile(name, data)
+	})
+}
+
+// loadEntries loads the palette files among the entries of a directory with load,
+// joining the errors of all malformed files.
+func loadEntries(entries []fs.DirEntry, load func(name string) (*Palette, error)) ([]*Palette, error) {
+	var palettes []*Palette
+	var errs []error
+	for _, entry := range entries {
+		if entry.IsDir() || !IsPaletteFile(entry.Name()) {
+			continue
+		}
+
+		p, err := load(entry.Name())
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		palettes = append(palettes, p)
+	}
+
+	return palettes, errors.Join(errs...)
+}
+
+// decodeFile decodes the content of a palette definition file, with the decoder of its extension.
+// The file name prefixes the returned errors.
+func decodeFile(name string, data []byte) (*Palette, error) {
+	decode, ok := decoders[strings.ToLower(filepath.Ext(name))]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", name, ErrUnsupportedFile)
+	}
+
+	var doc Document
+	if err := decode(data, &doc); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	p, err := FromDocument(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return p, nil
+}
+
+// FromDocument creates a palette from its serializable representation, validating
+// the name, the color definitions and the role assignments.
+func FromDocument(doc Document) (*Palette, error) {
+	name := strings.TrimSpace(doc.Name)
+	if name == "" {
+		return nil, errors.New("palette name is missing")
+	}
+	if len(doc.Colors) == 0 {
+		return nil, fmt.Errorf("palette %q has no colors", name)
+	}
+
+	p := NewPalette(name, doc.Families...)
+	p.SetMetadata(doc.Metadata)
+	for i, color := range doc.Colors {
+		if color.Hex == "" {
+			// An unquoted '#' starts a comment in YAML and TOML, which silently empties the value
+			p.errs = append(p.errs, fmt.Errorf("color #%d (%q): missing hex value (values starting with '#' must be quoted)",
+				i+1, color.Name))
+			continue
+		}
+		p.AddColor(color.Name, color.Hex)
+	}
+
+	// Role names are normalized, so that aliases such as "background" work in files
+	keys := make([]string, 0, len(doc.Roles))
+	for key := range doc.Roles {
+		keys = append(keys, string(key))
+	}
+	sort.Strings(keys)
+
+	roles := make(map[Role]string, len(keys))
+	for _, key := range keys {
+		role, err := ParseRole(key)
+		if err != nil {
+			p.errs = append(p.errs, err)
+			continue
+		}
+		roles[role] = doc.Roles[Role(key)]
+	}
+	p.SetRoles(roles)
+
+	if err := p.Err(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// decodeJSON decodes a JSON palette document, rejecting unknown fields.
+func decodeJSON(data []byte, doc *Document) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(doc); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+	return nil
+}
+
+// decodeYAML decodes a YAML palette document, rejecting unknown fields.
+func decodeYAML(data []byte, doc *Document) error {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(doc); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("invalid YAML: %w", err)
+	}
+	return nil
+}
+
+// decodeTOML decodes a TOML palette document, rejecting unknown fields.
+func decodeTOML(data []byte, doc *Document) error {
+	dec := toml.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(doc); err != nil {
+		return fmt.Errorf("invalid TOML: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package palette_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dr8co/palettes/palette"
+)
+
+// writeFiles writes files, by name, to a new temporary directory and returns the directory.
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// malformedFiles are palette files with a single mistake, and the error reported for them.
+var malformedFiles = []struct {
+	name, content, wantErr string
+}{
+	{
+		name:    "unknown.json",
+		content: `{"name": "A", "colors": [{"name": "bg", "hex": "#000000"}], "colours": []}`,
+		wantErr: `invalid JSON: json: unknown field "colours"`,
+	},
+	{
+		name:    "unknown.yaml",
+		content: "name: A\nauthors: Jane\ncolors:\n  - {name: bg, hex: \"#000000\"}\n",
+		wantErr: "invalid YAML: yaml: unmarshal errors:\n  line 2: field authors not found",
+	},
+	{
+		name:    "unknown.toml",
+		content: "name = \"A\"\ncolor = []\n",
+		wantErr: "invalid TOML: strict mode: fields in the document are missing in the target struct",
+	},
+	{
+		name:    "unquoted.yaml",
+		content: "name: B\ncolors:\n  - name: bg\n    hex: #000000\n",
+		wantErr: `palette "B": color #1 ("bg"): missing hex value (values starting with '#' must be quoted)`,
+	},
+	{
+		name:    "unnamed.toml",
+		content: "colors = [{name = \"bg\", hex = \"#000000\"}]\n",
+		wantErr: "palette name is missing",
+	},
+	{
+		name:    "role.yaml",
+		content: "name: C\ncolors:\n  - {name: bg, hex: \"#000000\"}\nroles:\n  bogus: bg\n",
+		wantErr: `palette "C": unknown color role 'bogus'`,
+	},
+	{
+		name:    "hex.json",
+		content: `{"name": "D", "colors": [{"name": "bg", "hex": "#00000g"}]}`,
+		wantErr: `palette "D": color "bg": invalid hex color "#00000g": unexpected character 'g'`,
+	},
+}
+
+func TestLoadFileErrors(t *testing.T) {
+	t.Parallel()
+
+	files := make(map[string]string, len(malformedFiles))
+	for _, f := range malformedFiles {
+		files[f.name] = f.content
+	}
+	dir := writeFiles(t, files)
+
+	for _, f := range malformedFiles {
+		path := filepath.Join(dir, f.name)
+		_, err := palette.LoadFile(path)
+		if want := path + ": " + f.wantErr; err == nil || !strings.HasPrefix(err.Error(), want) {
+			t.Errorf("LoadFile(%s) error = %v, want %q", f.name, err, want)
+		}
+	}
+
+	_, err := palette.LoadFile(filepath.Join(dir, "palette.txt"))
+	if !errors.Is(err, palette.ErrUnsupportedFile) {
+		t.Errorf("LoadFile(palette.txt) error = %v, want an error wrapping ErrUnsupportedFile", err)
+	}
+}
+
+// TestLoadDirErrors checks that LoadDir attempts every file and joins the errors of the malformed ones.
+func TestLoadDirErrors(t *testing.T) {
+	t.Parallel()
+
+	files := map[string]string{
+		"valid.yaml": "name: Valid\ncolors:\n  - {name: bg, hex: \"#000000\"}\n",
+		"notes.txt":  "not a palette",
+	}
+	for _, f := range malformedFiles {
+		files[f.name] = f.content
+	}
+	dir := writeFiles(t, files)
+	if err := os.Mkdir(filepath.Join(dir, "nested.json"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := palette.LoadDir(dir)
+	if err == nil {
+		t.Fatal("LoadDir returned no error")
+	}
+	for _, f := range malformedFiles {
+		if want := filepath.Join(dir, f.name) + ": " + f.wantErr; !strings.Contains(err.Error(), want) {
+			t.Errorf("LoadDir error does not report %s:\n%v", f.name, err)
+		}
+	}
+	for _, ignored := range []string{"valid.yaml", "notes.txt", "nested.json"} {
+		if strings.Contains(err.Error(), ignored) {
+			t.Errorf("LoadDir error reports %s:\n%v", ignored, err)
+		}
+	}
+
+	palettes, err := palette.LoadDir(writeFiles(t, map[string]string{
+		"b.yaml": files["valid.yaml"],
+		"a.json": `{"name": "First", "colors": [{"name": "bg", "hex": "#ffffff"}]}`,
+	}))
+	if err != nil || len(palettes) != 2 || palettes[0].Name() != "First" || palettes[1].Name() != "Valid" {
+		t.Errorf("LoadDir of valid files = %v, %v; want First and Valid", palettes, err)
+	}
+}
@@ -0,0 +1,135 @@
+package palette
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/dr8co/palettes/registry"
+	"golang.org/x/text/cases"
+)
+
+// ErrColorNotFound is returned, wrapped in a [*ColorNotFoundError], when a palette
+// has no color with the requested name.
+var ErrColorNotFound = errors.New("color not found")
+
+// ErrPaletteNotFound is returned by [Get] when no built-in palette has the requested name.
+// It is the same error as [registry.ErrSchemeNotFound].
+var ErrPaletteNotFound = registry.ErrSchemeNotFound
+
+// ColorNotFoundError is the error returned by [Palette.Color] for an unknown color name.
+// It wraps [ErrColorNotFound].
+type ColorNotFoundError struct {
+	// Palette is the name of the palette.
+	Palette string
+
+	// Name is the requested color name.
+	Name string
+}
+
+// Error implements the error interface.
+func (e *ColorNotFoundError) Error() string {
+	return fmt.Sprintf("palette %q has no color named %q", e.Palette, e.Name)
+}
+
+// Unwrap returns [ErrColorNotFound].
+func (e *ColorNotFoundError) Unwrap() error {
+	return ErrColorNotFound
+}
+
+// builtinRegistry holds the built-in palettes, loaded on first use. They are never modified:
+// [Default] and [Get] hand out copies.
+var builtinRegistry = sync.OnceValue(func() *registry.SchemeRegistry {
+	reg := registry.NewSchemeRegistry()
+	if err := RegisterAllSchemes(reg); err != nil {
+		// The palette data is embedded in the binary, so this is a build defect
+		panic(fmt.Sprintf("palette: loading the built-in palettes: %v", err))
+	}
+	return reg
+})
+
+// Default returns a registry populated with all the built-in palettes.
+//
+// Each call returns a new registry holding copies of the palettes, which the caller can
+// modify (registering, replacing or removing palettes) without affecting other callers.
+// Use [Get] to look up a single palette without copying the others.
+func Default() *registry.SchemeRegistry {
+	reg := registry.NewSchemeRegistry()
+	for _, name := range builtinRegistry().List() {
+		scheme, _ := builtinRegistry().Get(name)
+		if p, ok := scheme.(*Palette); ok {
+			scheme = p.clone()
+		}
+		reg.Register(scheme)
+	}
+	return reg
+}
+
+// Get returns a copy of a palette of the [Default] registry, looked up by name
+// ignoring case, accents and separators ("Catppuccin Mocha", "catppuccin-mocha").
+// The copy can be modified without affecting the registry.
+// It returns an error wrapping [ErrPaletteNotFound] if there is no such palette.
+func Get(name string) (*Palette, error) {
+	scheme, ok := builtinRegistry().Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrPaletteNotFound, name)
+	}
+
+	p, ok := scheme.(*Palette)
+	if !ok {
+		return nil, fmt.Errorf("palette %s does not provide color definitions", scheme.Name())
+	}
+	return p.clone(), nil
+}
+
+// MustGet is like [Get] but panics if the palette does not exist.
+// It simplifies the initialization of package-level variables:
+//
+//	var theme = palette.MustGet("Catppuccin Mocha")
+func MustGet(name string) *Palette {
+	p, err := Get(name)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// Color returns the color of the palette with the given name, ignoring case, whitespace,
+// hyphens and underscores ("Mauve", "mauve"; "Highlight Med", "highlight-med").
+// If several colors share the name, the first one is returned.
+// It returns a [*ColorNotFoundError] if there is no such color.
+//
+// The color value implements [image/color.Color], so it can be passed directly to lipgloss:
+//
+//	mauve, err := palette.MustGet("Catppuccin Mocha").Color("mauve")
+//	style := lipgloss.NewStyle().Foreground(mauve.Value)
+func (p *Palette) Color(name string) (Color, error) {
+	key := colorKey(name)
+	for _, color := range p.colors {
+		if colorKey(color.Def.Name) == key {
+			return color, nil
+		}
+	}
+	return Color{}, &ColorNotFoundError{Palette: p.name, Name: name}
+}
+
+// MustColor is like [Palette.Color] but panics if the color does not exist.
+func (p *Palette) MustColor(name string) Color {
+	color, err := p.Color(name)
+	if err != nil {
+		panic(err)
+	}
+	return color
+}
+
+// colorKey normalizes a color name for lookups: case-folded, without separators.
+func colorKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '-' || r == '_' {
+			return -1
+		}
+		return r
+	}, cases.Fold().String(name))
+}
@@ -0,0 +1,99 @@
+package palette_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/dr8co/palettes/palette"
+)
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"Catppuccin Mocha", "catppuccin-mocha", "CATPPUCCIN_MOCHA"} {
+		p, err := palette.Get(name)
+		if err != nil {
+			t.Fatalf("Get(%q) error = %v", name, err)
+		}
+		if p.Name() != "Catppuccin mocha" {
+			t.Errorf("Get(%q) = %s, want Catppuccin mocha", name, p.Name())
+		}
+	}
+
+	if _, err := palette.Get("Catppuccin Espresso"); !errors.Is(err, palette.ErrPaletteNotFound) {
+		t.Errorf("Get(Catppuccin Espresso) error = %v, want ErrPaletteNotFound", err)
+	}
+
+	// Modifying the returned palette does not affect the default registry
+	palette.MustGet("Dracula").AddFamily("modified")
+	if palette.MustGet("Dracula").HasFamily("modified") {
+		t.Error("modifying a palette returned by Get changed the default registry")
+	}
+}
+
+func TestDefault(t *testing.T) {
+	t.Parallel()
+
+	reg := palette.Default()
+	if got := len(reg.List()); got != 20 {
+		t.Errorf("Default() has %d palettes, want 20", got)
+	}
+
+	// Modifying the registry or its palettes does not affect other callers
+	reg.Unregister("Dracula")
+	scheme, _ := reg.Lookup("nord frost")
+	scheme.(*palette.Palette).AddFamily("modified")
+
+	other := palette.Default()
+	if _, ok := other.Lookup("Dracula"); !ok {
+		t.Error("unregistering a palette from a registry returned by Default changed the others")
+	}
+	if scheme, _ := other.Lookup("nord frost"); scheme.(*palette.Palette).HasFamily("modified") {
+		t.Error("modifying a palette of a registry returned by Default changed the others")
+	}
+	if palette.MustGet("nord frost").HasFamily("modified") {
+		t.Error("modifying a palette of a registry returned by Default changed the palettes returned by Get")
+	}
+}
+
+func TestPaletteColor(t *testing.T) {
+	t.Parallel()
+
+	p := palette.MustGet("Rosé Pine Moon")
+	for _, name := range []string{"Highlight Med", "highlight med", "highlight-med", "HighlightMed", " HIGHLIGHT_MED "} {
+		color, err := p.Color(name)
+		if err != nil {
+			t.Fatalf("Color(%q) error = %v", name, err)
+		}
+		if color.Def.Hex != "#44415a" {
+			t.Errorf("Color(%q) = %s, want #44415a", name, color.Def.Hex)
+		}
+	}
+
+	_, err := p.Color("Mauve")
+	var notFound *palette.ColorNotFoundError
+	if !errors.As(err, &notFound) || !errors.Is(err, palette.ErrColorNotFound) {
+		t.Fatalf("Color(Mauve) error = %v, want a ColorNotFoundError", err)
+	}
+	if notFound.Palette != p.Name() || notFound.Name != "Mauve" {
+		t.Errorf("Color(Mauve) error = %+v, want the palette and color names", notFound)
+	}
+}
+
+func ExamplePalette_Color() {
+	mocha := palette.MustGet("Catppuccin Mocha")
+
+	mauve, err := mocha.Color("mauve")
+	if err != nil {
+		panic(err)
+	}
+	bg, _ := mocha.Role(palette.RoleBackground)
+
+	// The color values can be passed directly to lipgloss, e.g. lipgloss.NewStyle().Foreground(mauve.Value)
+	fmt.Println(mauve.Def.Name, mauve.Value.Hex())
+	fmt.Println(bg.Def.Name, bg.Value.Hex())
+	// Output:
+	// Mauve #cba6f7
+	// Base #1e1e2e
+}
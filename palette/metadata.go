@@ -0,0 +1,29 @@
+package palette
+
+import (
+	"strings"
+
+	"github.com/dr8co/palettes/scheme"
+)
+
+// Metadata describes the origin of a palette (author, upstream URL, license, description and
+// upstream version), so that themes generated from it can be attributed properly.
+type Metadata = scheme.Metadata
+
+// SetMetadata sets the attribution details of the palette.
+// Surrounding whitespace is trimmed from every field.
+func (p *Palette) SetMetadata(meta Metadata) *Palette {
+	p.meta = Metadata{
+		Author:      strings.TrimSpace(meta.Author),
+		URL:         strings.TrimSpace(meta.URL),
+		License:     strings.TrimSpace(meta.License),
+		Description: strings.TrimSpace(meta.Description),
+		Version:     strings.TrimSpace(meta.Version),
+	}
+	return p
+}
+
+// Metadata returns the attribution details of the palette.
+func (p *Palette) Metadata() Metadata {
+	return p.meta
+}
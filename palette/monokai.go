@@ -1,30 +0,0 @@
-package palette
-
-// CreateMonokaiProPalette creates the Monokai Pro color palette.
-func CreateMonokaiProPalette() *Palette {
-	palette := NewPalette("Monokai Pro", "Monokai Pro", "Monokai")
-
-	colors := []ColorDefinition{
-		{"dark2", "#19181a"},
-		{"dark1", "#221f22"},
-		{"background", "#2d2a2e"},
-		{"text", "#fcfcfa"},
-		{"accent1", "#ff6188"},
-		{"accent2", "#fc9867"},
-		{"accent3", "#ffd866"},
-		{"accent4", "#a9dc76"},
-		{"accent5", "#78dce8"},
-		{"accent6", "#ab9df2"},
-		{"dimmed1", "#c1c0c0"},
-		{"dimmed2", "#939293"},
-		{"dimmed3", "#727072"},
-		{"dimmed4", "#5b595c"},
-		{"dimmed5", "#403e41"},
-	}
-
-	for _, color := range colors {
-		palette.AddColor(color.Name, color.Hex)
-	}
-
-	return palette
-}
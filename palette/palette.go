@@ -9,9 +9,13 @@
 // Key features:
 //   - Create and manage color palettes with names and families
 //   - Add individual colors or multiple colors at once
+//   - Validate hex colors and convert them to HSL, HSV, CIE XYZ/Lab and OKLab/OKLCH
+//   - Map colors to semantic roles (background, foreground, ANSI colors, accent, ...)
 //   - Display palettes in the terminal with variously styled text
 //   - Group palettes by families for better organization
-//   - Support for various popular terminal and editor color schemes
+//   - Look up the built-in palettes ([Get], [Default]) and their colors by name ([Palette.Color])
+//   - Support for various popular terminal and editor color schemes, defined in embedded
+//     data files that share the format of user palettes (see [LoadFile])
 //
 // Example usage:
 //
@@ -19,13 +23,20 @@
 //	palette.AddColor("background", "#282a36")
 //	palette.AddColor("foreground", "#f8f8f2")
 //	palette.Show()
+//
+// Palettes can also be rendered to any [io.Writer] with [Palette.WriteTo], or to a string
+// with [Palette.Render] for embedding in other lipgloss layouts.
 package palette
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"maps"
+	"slices"
 	"strings"
 
-	"charm.land/lipgloss/v2"
+	"github.com/dr8co/palettes/scheme"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
@@ -35,19 +46,20 @@ var (
 	placeHolderText = [5]string{"Lorem", "ipsum", "dolor", "sit", "amet"}
 )
 
-// ColorScheme defines the interface for color schemes
+// ColorScheme is the interface implemented by the color schemes of this package: [Palette],
+// [LayoutView] and [ProfilePreview]. It extends [scheme.ColorScheme] with the styled colors.
+//
+// Registries hold [scheme.ColorScheme] values, which do not have the Colors method;
+// use a type assertion to get the colors of a registry entry:
+//
+//	if cs, ok := entry.(palette.ColorScheme); ok {
+//		colors := cs.Colors()
+//	}
 type ColorScheme interface {
-	// Name returns the name of the color scheme.
-	Name() string
+	scheme.ColorScheme
 
-	// Show displays the color scheme.
-	Show()
-
-	// Colors returns the color definitions.
+	// Colors returns the colors, in palette order.
 	Colors() []Color
-
-	// Families returns the color families.
-	Families() []string
 }
 
 // Palette represents a collection of colors with a theme name and families.
@@ -55,6 +67,12 @@ type Palette struct {
 	name     string
 	families []string
 	colors   []Color
+	roles    map[Role]string
+	meta     Metadata
+	errs     []error
+
+	// resolved caches the resolved roles; it is replaced whenever the palette is modified
+	resolved *roleCache
 }
 
 // NewPalette creates a new palette with the given name and families.
@@ -63,25 +81,34 @@ func NewPalette(name string, families ...string) *Palette {
 		name:     name,
 		families: families,
 		colors:   make([]Color, 0, 32),
+		resolved: new(roleCache),
 	}
 }
 
 // AddColor adds a single color to the palette.
+// Invalid colors are not added; the validation error is recorded and reported by [Palette.Err].
 func (p *Palette) AddColor(name, hex string) *Palette {
-	color := NewColor(name, hex)
+	color, err := NewColor(name, hex)
+	if err != nil {
+		p.errs = append(p.errs, err)
+		return p
+	}
 	p.colors = append(p.colors, *color)
+	p.resolved = new(roleCache)
 	return p
 }
 
 // AddColors adds multiple colors to the palette.
 func (p *Palette) AddColors(colors ...Color) *Palette {
 	p.colors = append(p.colors, colors...)
+	p.resolved = new(roleCache)
 	return p
 }
 
 // AddFamily adds a family to the palette's families
 func (p *Palette) AddFamily(family string) *Palette {
 	p.families = append(p.families, family)
+	p.resolved = new(roleCache)
 	return p
 }
 
@@ -100,6 +127,23 @@ func (p *Palette) Colors() []Color {
 	return p.colors
 }
 
+// Definitions returns the definitions (name and hex value) of the palette colors.
+func (p *Palette) Definitions() []ColorDefinition {
+	defs := make([]ColorDefinition, 0, len(p.colors))
+	for _, color := range p.colors {
+		defs = append(defs, color.Def)
+	}
+	return defs
+}
+
+// Err returns the validation errors recorded while building the palette, or nil if there were none.
+func (p *Palette) Err() error {
+	if len(p.errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("palette %q: %w", p.name, errors.Join(p.errs...))
+}
+
 // HasFamily checks if the palette belongs to a specific family.
 func (p *Palette) HasFamily(family string) bool {
 	family = strings.ToLower(family)
@@ -111,24 +155,35 @@ func (p *Palette) HasFamily(family string) bool {
 	return false
 }
 
-// Show displays the palette.
-func (p *Palette) Show() {
-	title := lipgloss.NewStyle().Bold(true).Render(titleCaser.String(p.name))
-	fmt.Println("Palette:", title)
+// Render returns the styled rendering of the palette with the text layout and the default width,
+// as displayed by [Palette.Show] on terminals of unknown width. See [Palette.RenderLayout].
+func (p *Palette) Render() string {
+	return p.RenderLayout(LayoutText, DefaultWidth)
+}
 
-	for _, color := range p.colors {
-		style := color.Style
-		regularText := style.Render(placeHolderText[0])
-		italicText := style.Italic(true).Render(placeHolderText[1])
-		boldText := style.Bold(true).Render(placeHolderText[2])
-		underlineText := style.Underline(true).UnderlineSpaces(true).Render(placeHolderText[3])
-		strikethroughText := style.Strikethrough(true).Render(placeHolderText[4])
+// WriteTo writes the rendering of the palette to w.
+// It implements the [io.WriterTo] interface.
+func (p *Palette) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, p.Render())
+	if err != nil {
+		return int64(n), fmt.Errorf("writing palette %s: %w", p.name, err)
+	}
+	return int64(n), nil
+}
 
-		bar := style.Reverse(true).Render(fmt.Sprintf(" %-20s %-13s                 ",
-			titleCaser.String(color.Def.Name), color.Def.Hex))
+// Show displays the palette on the standard output, fitted to the width of the terminal and
+// downsampling the colors to its profile (and stripping them if NO_COLOR is set).
+func (p *Palette) Show() {
+	p.WithLayout(LayoutText, TerminalWidth()).Show()
+}
 
-		fmt.Printf("%s %s %s %s %s  %s\n", regularText, italicText, boldText, underlineText, strikethroughText, bar)
-	}
-	fmt.Println(strings.Repeat("─", 80))
-	fmt.Println()
+// clone returns a copy of the palette that can be modified independently.
+func (p *Palette) clone() *Palette {
+	c := *p
+	c.families = slices.Clone(p.families)
+	c.colors = slices.Clone(p.colors)
+	c.roles = maps.Clone(p.roles)
+	c.errs = slices.Clone(p.errs)
+	c.resolved = new(roleCache)
+	return &c
 }
@@ -0,0 +1,149 @@
+package palette_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/charmbracelet/colorprofile"
+	"github.com/dr8co/palettes/internal/golden"
+	"github.com/dr8co/palettes/palette"
+	"github.com/dr8co/palettes/registry"
+)
+
+// TestPaletteGolden renders every bundled palette and compares it with its golden file.
+func TestPaletteGolden(t *testing.T) {
+	t.Parallel()
+
+	reg := registry.NewSchemeRegistry()
+	if err := palette.RegisterAllSchemes(reg); err != nil {
+		t.Fatalf("RegisterAllSchemes: %v", err)
+	}
+
+	for _, name := range reg.List() {
+		scheme, _ := reg.Get(name)
+		t.Run(slug(name), func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			w := &colorprofile.Writer{Forward: &buf, Profile: colorprofile.TrueColor}
+			if _, err := scheme.WriteTo(w); err != nil {
+				t.Fatalf("WriteTo: %v", err)
+			}
+			golden.Assert(t, slug(name), buf.Bytes())
+		})
+	}
+}
+
+// TestRegisterAllSchemes checks that every bundled palette is valid and registered.
+func TestRegisterAllSchemes(t *testing.T) {
+	t.Parallel()
+
+	reg := registry.NewSchemeRegistry()
+	if err := palette.RegisterAllSchemes(reg); err != nil {
+		t.Fatalf("RegisterAllSchemes: %v", err)
+	}
+
+	const want = 20
+	if got := len(reg.List()); got != want {
+		t.Errorf("registered %d palettes, want %d", got, want)
+	}
+
+	// Registry results expose their colors and roles without a type assertion
+	scheme, ok := reg.Lookup("dracula")
+	if !ok {
+		t.Fatal("Lookup(dracula) found no palette")
+	}
+	if got := len(scheme.Definitions()); got != 12 {
+		t.Errorf("Dracula has %d colors, want 12", got)
+	}
+	if bg := scheme.RoleColors()[palette.RoleBackground]; bg.Hex != "#282a36" {
+		t.Errorf("Dracula background = %v, want #282a36", bg)
+	}
+
+	// and their styled colors through palette.ColorScheme
+	cs, ok := scheme.(palette.ColorScheme)
+	if !ok {
+		t.Fatalf("Dracula (%T) does not implement palette.ColorScheme", scheme)
+	}
+	if got := len(cs.Colors()); got != 12 {
+		t.Errorf("Dracula has %d styled colors, want 12", got)
+	}
+	for _, view := range []palette.ColorScheme{
+		cs.(*palette.Palette).WithLayout(palette.LayoutGrid, 0),
+		cs.(*palette.Palette).Preview(colorprofile.ANSI256),
+	} {
+		if got := len(view.Colors()); got != 12 {
+			t.Errorf("%T of Dracula has %d styled colors, want 12", view, got)
+		}
+	}
+}
+
+// TestBuiltinConstructors checks that the constructors of the bundled palettes
+// find every variant of the embedded data files.
+func TestBuiltinConstructors(t *testing.T) {
+	t.Parallel()
+
+	families := []struct {
+		colors   map[string][]palette.ColorDefinition
+		create   func(variant string) *palette.Palette
+		variants []string
+	}{
+		{palette.CatppuccinColors, palette.CreateCatppuccinPalette, []string{"latte", "frappe", "macchiato", "mocha"}},
+		{palette.TokyoNightColors, palette.CreateTokyoNightPalette, []string{"dark", "light"}},
+		{palette.RosePineColors, palette.CreateRosePinePalette, []string{"", "moon", "dawn"}},
+		{palette.NordColors, palette.CreateNordPalette, []string{"polar night", "snow storm", "frost", "aurora"}},
+		{palette.GruvboxColors, palette.CreateGruvboxPalette, []string{"dark", "light"}},
+	}
+
+	for _, family := range families {
+		if len(family.colors) != len(family.variants) {
+			t.Errorf("got %d variants, want %v", len(family.colors), family.variants)
+		}
+		for _, variant := range family.variants {
+			p := family.create(strings.ToUpper(variant))
+			if p == nil {
+				t.Errorf("no palette for variant %q", variant)
+				continue
+			}
+			if got, want := len(p.Colors()), len(family.colors[variant]); got != want || got == 0 {
+				t.Errorf("%s has %d colors, want %d", p.Name(), got, want)
+			}
+		}
+	}
+
+	for _, create := range []func() *palette.Palette{
+		palette.CreateEldritchPalette, palette.CreateDraculaPalette, palette.CreateMonokaiProPalette,
+		palette.CreateSolarizedPalette, palette.CreateEverblushPalette,
+	} {
+		if p := create(); p == nil || len(p.Colors()) == 0 || p.Metadata().URL == "" {
+			t.Errorf("incomplete bundled palette: %v", p)
+		}
+	}
+
+	// Every call returns a separate copy
+	p := palette.CreateDraculaPalette().AddFamily("modified")
+	if palette.CreateDraculaPalette().HasFamily("modified") {
+		t.Errorf("modifying %s changed the bundled palette", p.Name())
+	}
+	if palette.CreateGruvboxPalette("unknown") != nil {
+		t.Error("CreateGruvboxPalette(unknown) returned a palette, want nil")
+	}
+}
+
+// slug converts a palette name to a file-friendly identifier.
+func slug(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			dash = false
+		} else if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
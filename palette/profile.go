@@ -0,0 +1,139 @@
+package palette
+
+import (
+	"fmt"
+	"image/color"
+	"io"
+	"os"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/colorprofile"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// ProfilePreview renders a palette as it would appear on a terminal limited to a color profile:
+// each color is shown next to its nearest approximation under the profile, with the
+// CIEDE2000 difference ([DeltaE]) between them.
+//
+// Under the 16-color profile, the approximations are basic ANSI colors, whose actual appearance
+// depends on the terminal theme; their differences are computed with the xterm default colors.
+// Profiles without colors (ASCII and NoTTY) have no approximations.
+//
+// A ProfilePreview implements [ColorScheme], so previews can be registered and shown like palettes.
+type ProfilePreview struct {
+	*Palette
+
+	// Profile is the color profile the palette is approximated for.
+	Profile colorprofile.Profile
+
+	// Width is the maximum width of the rendering, in columns. It defaults to [DefaultWidth].
+	Width int
+}
+
+// Preview returns a preview of the palette under a color profile, fitted to the default width.
+// Set [ProfilePreview.Width] to fit it to another width.
+func (p *Palette) Preview(profile colorprofile.Profile) *ProfilePreview {
+	return &ProfilePreview{Palette: p, Profile: profile}
+}
+
+// Render returns the styled rendering of the preview, as displayed by [ProfilePreview.Show].
+// Lines wider than the width are truncated.
+func (v *ProfilePreview) Render() string {
+	width := v.Width
+	if width <= 0 {
+		width = DefaultWidth
+	}
+
+	var b strings.Builder
+
+	title := lipgloss.NewStyle().Bold(true).Render(titleCaser.String(v.name))
+	fmt.Fprintf(&b, "Palette: %s (%s)\n", title, profileLabel(v.Profile))
+
+	var total, worst float64
+	var worstName string
+	swatch := strings.Repeat(" ", 6)
+	for _, c := range v.colors {
+		original := lipgloss.NewStyle().Background(c.Value).Render(swatch)
+
+		approx := v.Profile.Convert(c.Value)
+		if approx == nil {
+			fmt.Fprintf(&b, "%s %s  %-20s %-9s  →  no color\n", original, swatch,
+				titleCaser.String(c.Def.Name), c.Def.Hex)
+			continue
+		}
+
+		value := rgbaOf(approx)
+		delta := DeltaE(c.Value, value)
+		total += delta
+		if delta > worst {
+			worst, worstName = delta, c.Def.Name
+		}
+
+		fmt.Fprintf(&b, "%s %s  %-20s %-9s  →  %-19s ΔE %5.2f\n", original,
+			lipgloss.NewStyle().Background(approx).Render(swatch),
+			titleCaser.String(c.Def.Name), c.Def.Hex, approxLabel(approx, value), delta)
+	}
+
+	if v.Profile > colorprofile.ASCII && len(v.colors) > 0 {
+		fmt.Fprintf(&b, "Mean ΔE %.2f, max ΔE %.2f", total/float64(len(v.colors)), worst)
+		if worstName != "" {
+			fmt.Fprintf(&b, " (%s)", titleCaser.String(worstName))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(strings.Repeat("─", width) + "\n\n")
+
+	return fitLines(b.String(), width)
+}
+
+// WriteTo writes the rendering of the preview to w.
+// It implements the [io.WriterTo] interface.
+func (v *ProfilePreview) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, v.Render())
+	if err != nil {
+		return int64(n), fmt.Errorf("writing palette %s: %w", v.name, err)
+	}
+	return int64(n), nil
+}
+
+// Show displays the preview on the standard output.
+func (v *ProfilePreview) Show() {
+	_, _ = v.WriteTo(colorprofile.NewWriter(os.Stdout, os.Environ()))
+}
+
+// profileLabel describes the colors available under a profile.
+func profileLabel(profile colorprofile.Profile) string {
+	switch profile {
+	case colorprofile.TrueColor:
+		return "true color"
+	case colorprofile.ANSI256:
+		return "256 colors"
+	case colorprofile.ANSI:
+		return "16 colors"
+	default:
+		return "no colors"
+	}
+}
+
+// approxLabel describes an approximated color: its hex value, followed by its
+// index in the terminal palette for the 256 and 16-color profiles.
+func approxLabel(c color.Color, value RGBA) string {
+	switch c := c.(type) {
+	case ansi.IndexedColor:
+		return fmt.Sprintf("%s (color %d)", value.Hex(), c)
+	case ansi.BasicColor:
+		return fmt.Sprintf("%s (color %d)", value.Hex(), c)
+	default:
+		return value.Hex()
+	}
+}
+
+// rgbaOf converts any color to an [RGBA] color.
+func rgbaOf(c color.Color) RGBA {
+	if rgba, ok := c.(RGBA); ok {
+		return rgba
+	}
+	n, _ := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return RGBA{R: n.R, G: n.G, B: n.B, A: n.A}
+}
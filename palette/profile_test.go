@@ -0,0 +1,42 @@
+package palette_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/colorprofile"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/dr8co/palettes/palette"
+)
+
+// TestPreviewWidth checks that the previews of every bundled palette fit in the requested width.
+func TestPreviewWidth(t *testing.T) {
+	t.Parallel()
+
+	reg := palette.Default()
+	profiles := []colorprofile.Profile{colorprofile.TrueColor, colorprofile.ANSI256, colorprofile.ANSI, colorprofile.ASCII}
+	for _, profile := range profiles {
+		for _, width := range []int{40, 80} {
+			t.Run(fmt.Sprintf("%s-%d", profile, width), func(t *testing.T) {
+				t.Parallel()
+
+				for _, name := range reg.List() {
+					scheme, _ := reg.Get(name)
+					p, ok := scheme.(*palette.Palette)
+					if !ok {
+						continue
+					}
+
+					preview := p.Preview(profile)
+					preview.Width = width
+					for i, line := range strings.Split(preview.Render(), "\n") {
+						if got := ansi.StringWidth(line); got > width {
+							t.Errorf("%s: line %d is %d columns wide, want at most %d: %q", name, i+1, got, width, ansi.Strip(line))
+						}
+					}
+				}
+			})
+		}
+	}
+}
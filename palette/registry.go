@@ -1,56 +1,20 @@
 package palette
 
-import "github.com/dr8co/palettes/registry"
+import (
+	"errors"
 
-// RegisterAllSchemes initializes and registers all available color schemes.
-func RegisterAllSchemes(reg *registry.SchemeRegistry) {
-	// Register Catppuccin palettes
-	for variant := range CatppuccinColors {
-		if palette := CreateCatppuccinPalette(variant); palette != nil {
-			reg.Register(palette)
-		}
-	}
-
-	// Register Rosé Pine palettes
-	for variant := range RosePineColors {
-		if palette := CreateRosePinePalette(variant); palette != nil {
-			reg.Register(palette)
-		}
-	}
-
-	// Eldritch palette
-	reg.Register(CreateEldritchPalette())
-
-	// Tokyo Night
-	for variant := range TokyoNightColors {
-		if palette := CreateTokyoNightPalette(variant); palette != nil {
-			reg.Register(palette)
-		}
-	}
+	"github.com/dr8co/palettes/registry"
+)
 
-	// Nord
-	for variant := range NordColors {
-		if palette := CreateNordPalette(variant); palette != nil {
-			reg.Register(palette)
-		}
-	}
-
-	// Gruvbox
-	for variant := range GruvboxColors {
-		if palette := CreateGruvboxPalette(variant); palette != nil {
-			reg.Register(palette)
+// RegisterAllSchemes initializes and registers all available color schemes.
+// It returns the errors of any malformed built-in palette definition and of palettes
+// whose name is already registered; such palettes are not registered.
+func RegisterAllSchemes(reg *registry.SchemeRegistry) error {
+	errs := []error{builtinErr}
+	for _, palette := range builtins {
+		if err := reg.RegisterUnique(palette.clone()); err != nil {
+			errs = append(errs, err)
 		}
 	}
-
-	// Monokai Pro
-	reg.Register(CreateMonokaiProPalette())
-
-	// Dracula
-	reg.Register(CreateDraculaPalette())
-
-	// Solarized
-	reg.Register(CreateSolarizedPalette())
-
-	// Everblush
-	reg.Register(CreateEverblushPalette())
+	return errors.Join(errs...)
 }
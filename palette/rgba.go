@@ -0,0 +1,104 @@
+package palette
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidHex is returned when a hex color string cannot be parsed.
+var ErrInvalidHex = errors.New("invalid hex color")
+
+// RGBA is a parsed sRGB color with 8-bit channels and straight (non-premultiplied) alpha.
+//
+// RGBA implements the [image/color.Color] interface, so it can be passed directly
+// to lipgloss styles and anything else accepting a standard library color.
+type RGBA struct {
+	R, G, B, A uint8
+}
+
+// ParseHex parses a hex color string in one of the forms #rgb, #rgba, #rrggbb or #rrggbbaa.
+// The leading '#' is required. Colors without an alpha component are fully opaque.
+func ParseHex(hex string) (RGBA, error) {
+	digits, ok := strings.CutPrefix(hex, "#")
+	if !ok {
+		return RGBA{}, fmt.Errorf("%w %q: missing leading '#'", ErrInvalidHex, hex)
+	}
+
+	nibbles := make([]uint8, len(digits))
+	for i := range len(digits) {
+		n, ok := hexNibble(digits[i])
+		if !ok {
+			return RGBA{}, fmt.Errorf("%w %q: unexpected character %q", ErrInvalidHex, hex, digits[i])
+		}
+		nibbles[i] = n
+	}
+
+	switch len(nibbles) {
+	case 3, 4:
+		// Short forms: each digit is duplicated (e.g., #f80 == #ff8800)
+		c := RGBA{R: nibbles[0] * 0x11, G: nibbles[1] * 0x11, B: nibbles[2] * 0x11, A: 0xff}
+		if len(nibbles) == 4 {
+			c.A = nibbles[3] * 0x11
+		}
+		return c, nil
+	case 6, 8:
+		c := RGBA{
+			R: nibbles[0]<<4 | nibbles[1],
+			G: nibbles[2]<<4 | nibbles[3],
+			B: nibbles[4]<<4 | nibbles[5],
+			A: 0xff,
+		}
+		if len(nibbles) == 8 {
+			c.A = nibbles[6]<<4 | nibbles[7]
+		}
+		return c, nil
+	default:
+		return RGBA{}, fmt.Errorf("%w %q: expected 3, 4, 6 or 8 hex digits, got %d", ErrInvalidHex, hex, len(nibbles))
+	}
+}
+
+// hexNibble converts a single hex digit to its value.
+func hexNibble(b byte) (uint8, bool) {
+	switch {
+	case b >= '0' && b <= '9':
+		return b - '0', true
+	case b >= 'a' && b <= 'f':
+		return b - 'a' + 10, true
+	case b >= 'A' && b <= 'F':
+		return b - 'A' + 10, true
+	default:
+		return 0, false
+	}
+}
+
+// RGBA implements the [image/color.Color] interface.
+// It returns alpha-premultiplied 16-bit channel values.
+func (c RGBA) RGBA() (r, g, b, a uint32) {
+	a = uint32(c.A)
+	a |= a << 8
+	r = uint32(c.R)
+	r |= r << 8
+	r = r * a / 0xffff
+	g = uint32(c.G)
+	g |= g << 8
+	g = g * a / 0xffff
+	b = uint32(c.B)
+	b |= b << 8
+	b = b * a / 0xffff
+	return r, g, b, a
+}
+
+// Hex returns the canonical lowercase hex representation of the color.
+// The alpha component is only included when the color is not fully opaque.
+func (c RGBA) Hex() string {
+	if c.A == 0xff {
+		return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+	}
+	return fmt.Sprintf("#%02x%02x%02x%02x", c.R, c.G, c.B, c.A)
+}
+
+// String returns the canonical hex representation of the color.
+func (c RGBA) String() string {
+	return c.Hex()
+}
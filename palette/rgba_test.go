@@ -0,0 +1,95 @@
+package palette_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dr8co/palettes/palette"
+)
+
+func TestParseHex(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		hex     string
+		want    palette.RGBA
+		wantErr string
+	}{
+		{hex: "#f80", want: palette.RGBA{R: 0xff, G: 0x88, B: 0x00, A: 0xff}},
+		{hex: "#F80C", want: palette.RGBA{R: 0xff, G: 0x88, B: 0x00, A: 0xcc}},
+		{hex: "#282a36", want: palette.RGBA{R: 0x28, G: 0x2a, B: 0x36, A: 0xff}},
+		{hex: "#282A3680", want: palette.RGBA{R: 0x28, G: 0x2a, B: 0x36, A: 0x80}},
+		{hex: "282a36", wantErr: `invalid hex color "282a36": missing leading '#'`},
+		{hex: "", wantErr: `invalid hex color "": missing leading '#'`},
+		{hex: "#282g36", wantErr: `invalid hex color "#282g36": unexpected character 'g'`},
+		{hex: "# 282a36", wantErr: `invalid hex color "# 282a36": unexpected character ' '`},
+		{hex: "#", wantErr: `invalid hex color "#": expected 3, 4, 6 or 8 hex digits, got 0`},
+		{hex: "#28", wantErr: `invalid hex color "#28": expected 3, 4, 6 or 8 hex digits, got 2`},
+		{hex: "#282a3", wantErr: `invalid hex color "#282a3": expected 3, 4, 6 or 8 hex digits, got 5`},
+		{hex: "#282a36ff0", wantErr: `invalid hex color "#282a36ff0": expected 3, 4, 6 or 8 hex digits, got 9`},
+	}
+
+	for _, tt := range tests {
+		got, err := palette.ParseHex(tt.hex)
+		if tt.wantErr == "" {
+			if err != nil || got != tt.want {
+				t.Errorf("ParseHex(%q) = %v, %v; want %v", tt.hex, got, err, tt.want)
+			}
+			continue
+		}
+
+		if err == nil || err.Error() != tt.wantErr {
+			t.Errorf("ParseHex(%q) error = %v, want %q", tt.hex, err, tt.wantErr)
+		}
+		if !errors.Is(err, palette.ErrInvalidHex) {
+			t.Errorf("ParseHex(%q) error %v does not wrap ErrInvalidHex", tt.hex, err)
+		}
+	}
+}
+
+func TestNewColor(t *testing.T) {
+	t.Parallel()
+
+	c, err := palette.NewColor("purple", "#bd93f9")
+	if err != nil {
+		t.Fatalf("NewColor(purple, #bd93f9): %v", err)
+	}
+	if want := (palette.RGBA{R: 0xbd, G: 0x93, B: 0xf9, A: 0xff}); c.Value != want || c.Def.Hex != "#bd93f9" {
+		t.Errorf("NewColor(purple, #bd93f9) = %v (%s), want %v", c.Value, c.Def.Hex, want)
+	}
+
+	_, err = palette.NewColor("purple", "bd93f9")
+	if !errors.Is(err, palette.ErrInvalidHex) || !strings.HasPrefix(err.Error(), `color "purple": `) {
+		t.Errorf("NewColor(purple, bd93f9) error = %v, want a named error wrapping ErrInvalidHex", err)
+	}
+}
+
+// TestPaletteErr checks that invalid colors are skipped and their errors accumulated.
+func TestPaletteErr(t *testing.T) {
+	t.Parallel()
+
+	p := palette.NewPalette("Broken", "dark").
+		AddColor("background", "#282a36").
+		AddColor("foreground", "f8f8f2").
+		AddColor("red", "#ff555").
+		AddColor("green", "#50fa7b")
+
+	if got := len(p.Colors()); got != 2 {
+		t.Errorf("palette has %d colors, want the 2 valid ones", got)
+	}
+
+	err := p.Err()
+	if !errors.Is(err, palette.ErrInvalidHex) {
+		t.Fatalf("Err() = %v, want an error wrapping ErrInvalidHex", err)
+	}
+	for _, want := range []string{`palette "Broken": `, `color "foreground"`, `color "red"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Err() = %q, does not mention %s", err, want)
+		}
+	}
+
+	if err := palette.NewPalette("Valid", "dark").AddColor("background", "#282a36").Err(); err != nil {
+		t.Errorf("Err() of a valid palette = %v, want nil", err)
+	}
+}
@@ -0,0 +1,179 @@
+package palette
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+
+	"github.com/dr8co/palettes/scheme"
+)
+
+// Role is a semantic color role, shared by all palettes regardless of how their colors are named.
+// See [scheme.Role].
+type Role = scheme.Role
+
+// Semantic color roles, re-exported from the scheme package.
+const (
+	RoleBackground = scheme.RoleBackground
+	RoleForeground = scheme.RoleForeground
+	RoleCursor     = scheme.RoleCursor
+	RoleSelection  = scheme.RoleSelection
+
+	RoleBlack   = scheme.RoleBlack
+	RoleRed     = scheme.RoleRed
+	RoleGreen   = scheme.RoleGreen
+	RoleYellow  = scheme.RoleYellow
+	RoleBlue    = scheme.RoleBlue
+	RoleMagenta = scheme.RoleMagenta
+	RoleCyan    = scheme.RoleCyan
+	RoleWhite   = scheme.RoleWhite
+
+	RoleBrightBlack   = scheme.RoleBrightBlack
+	RoleBrightRed     = scheme.RoleBrightRed
+	RoleBrightGreen   = scheme.RoleBrightGreen
+	RoleBrightYellow  = scheme.RoleBrightYellow
+	RoleBrightBlue    = scheme.RoleBrightBlue
+	RoleBrightMagenta = scheme.RoleBrightMagenta
+	RoleBrightCyan    = scheme.RoleBrightCyan
+	RoleBrightWhite   = scheme.RoleBrightWhite
+
+	RoleAccent  = scheme.RoleAccent
+	RoleError   = scheme.RoleError
+	RoleWarning = scheme.RoleWarning
+	RoleInfo    = scheme.RoleInfo
+)
+
+// ANSIRoles holds the roles of the 16 ANSI colors, in terminal order:
+// 0-7 are the normal colors and 8-15 their bright variants.
+var ANSIRoles = scheme.ANSIRoles
+
+// Roles returns all semantic roles, in canonical order.
+func Roles() []Role {
+	return scheme.Roles()
+}
+
+// ParseRole converts a role name (case-insensitive) to a [Role].
+// Besides the canonical names, "background", "foreground" and "warning" are accepted.
+func ParseRole(name string) (Role, error) {
+	return scheme.ParseRole(name)
+}
+
+// SetRole assigns a color of the palette to a semantic role.
+//
+// The reference is either the name of a color in the palette (case-insensitive; the first
+// match wins) or, when it starts with '#', the hex value of one. Invalid references are
+// recorded and reported by [Palette.Err].
+func (p *Palette) SetRole(role Role, ref string) *Palette {
+	if _, err := p.resolveRef(ref); err != nil {
+		p.errs = append(p.errs, fmt.Errorf("role %q: %w", role, err))
+		return p
+	}
+
+	if p.roles == nil {
+		p.roles = make(map[Role]string)
+	}
+	p.roles[role] = ref
+	p.resolved = new(roleCache)
+	return p
+}
+
+// SetRoles assigns multiple roles at once. See [Palette.SetRole].
+func (p *Palette) SetRoles(roles map[Role]string) *Palette {
+	// Iterate in canonical order so that recorded errors are deterministic
+	for _, role := range Roles() {
+		if ref, ok := roles[role]; ok {
+			p.SetRole(role, ref)
+		}
+	}
+	return p
+}
+
+// Role returns the color assigned to a semantic role.
+//
+// Roles without an explicit assignment are inferred from the palette colors,
+// so every role resolves as long as the palette has at least one color.
+func (p *Palette) Role(role Role) (Color, bool) {
+	color, ok := p.resolvedRoles()[role]
+	return color, ok
+}
+
+// ResolvedRoles returns the color of every semantic role: explicit assignments take
+// precedence, and the remaining roles are inferred from the palette colors.
+// It returns an empty map for a palette without colors.
+func (p *Palette) ResolvedRoles() map[Role]Color {
+	return maps.Clone(p.resolvedRoles())
+}
+
+// roleCache holds the resolved roles of a palette, computed on first use.
+type roleCache struct {
+	once  sync.Once
+	roles map[Role]Color
+}
+
+// resolvedRoles returns the cached resolved roles of the palette, which must not be modified.
+// The roles are resolved once after each modification of the palette, and shared by concurrent readers.
+func (p *Palette) resolvedRoles() map[Role]Color {
+	if p.resolved == nil {
+		return p.resolveRoles()
+	}
+	p.resolved.once.Do(func() {
+		p.resolved.roles = p.resolveRoles()
+	})
+	return p.resolved.roles
+}
+
+// resolveRoles resolves the explicit role assignments, and infers the missing roles.
+func (p *Palette) resolveRoles() map[Role]Color {
+	resolved := make(map[Role]Color, len(Roles()))
+	for role, ref := range p.roles {
+		if color, err := p.resolveRef(ref); err == nil {
+			resolved[role] = color
+		}
+	}
+
+	if len(p.colors) > 0 {
+		inferRoles(p, resolved)
+	}
+	return resolved
+}
+
+// RoleColors returns the definition of the color of every semantic role.
+// See [Palette.ResolvedRoles].
+func (p *Palette) RoleColors() map[Role]ColorDefinition {
+	resolved := p.resolvedRoles()
+	defs := make(map[Role]ColorDefinition, len(resolved))
+	for role, color := range resolved {
+		defs[role] = color.Def
+	}
+	return defs
+}
+
+// HasRole reports whether a role has an explicit assignment (as opposed to an inferred one).
+func (p *Palette) HasRole(role Role) bool {
+	_, ok := p.roles[role]
+	return ok
+}
+
+// resolveRef finds the palette color designated by a role reference.
+func (p *Palette) resolveRef(ref string) (Color, error) {
+	if strings.HasPrefix(ref, "#") {
+		value, err := ParseHex(ref)
+		if err != nil {
+			return Color{}, err
+		}
+		for _, color := range p.colors {
+			if color.Value == value {
+				return color, nil
+			}
+		}
+		return Color{}, fmt.Errorf("no color with value %s", ref)
+	}
+
+	for _, color := range p.colors {
+		if strings.EqualFold(color.Def.Name, ref) {
+			return color, nil
+		}
+	}
+	return Color{}, fmt.Errorf("no color named %q", ref)
+}
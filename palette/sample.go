@@ -0,0 +1,115 @@
+package palette
+
+import (
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// sampleSpan is a piece of the sample terminal session, colored with a semantic role.
+type sampleSpan struct {
+	text string
+
+	// role is the role of the text color; the zero value is the foreground.
+	role Role
+
+	bold, italic bool
+
+	// selected highlights the text with the selection color.
+	selected bool
+
+	// cursor draws the text as the cursor block.
+	cursor bool
+}
+
+// sampleSession returns the lines of a sample terminal session: a Go file with syntax highlighting,
+// a git diff, a colored directory listing and shell prompts.
+//
+// The colors follow the usual conventions of terminal applications for the ANSI colors.
+func sampleSession() [][]sampleSpan {
+	text := func(s string) sampleSpan { return sampleSpan{text: s} }
+	with := func(role Role, s string) sampleSpan { return sampleSpan{text: s, role: role} }
+	bold := func(role Role, s string) sampleSpan { return sampleSpan{text: s, role: role, bold: true} }
+
+	// Syntax highlighting
+	keyword := func(s string) sampleSpan { return with(RoleMagenta, s) }
+	typ := func(s string) sampleSpan { return with(RoleYellow, s) }
+	function := func(s string) sampleSpan { return with(RoleBlue, s) }
+	str := func(s string) sampleSpan { return with(RoleGreen, s) }
+	number := func(s string) sampleSpan { return with(RoleCyan, s) }
+	comment := func(s string) sampleSpan { return sampleSpan{text: s, role: RoleBrightBlack, italic: true} }
+
+	prompt := func(cmd string, failed bool) []sampleSpan {
+		status := with(RoleGreen, "❯")
+		if failed {
+			status = with(RoleRed, "❯")
+		}
+		return []sampleSpan{bold(RoleCyan, "~/src/hello"), text(" on "), bold(RoleMagenta, "main"), text(" "), status, text(" " + cmd)}
+	}
+
+	return [][]sampleSpan{
+		prompt("cat main.go", false),
+		{keyword("package"), text(" main")},
+		{},
+		{keyword("import"), text(" "), str(`"fmt"`)},
+		{},
+		{comment("// greet returns a greeting for name.")},
+		{keyword("func"), text(" "), function("greet"), text("(name "), typ("string"), text(", times "), typ("int"), text(") "), typ("string"), text(" {")},
+		{text("    "), keyword("if"), text(" times <= "), number("0"), text(" {")},
+		{text("        "), keyword("return"), text(" "), str(`""`)},
+		{text("    }")},
+		{text("    "), keyword("return"), text(" fmt."), sampleSpan{text: "Sprintf", role: RoleBlue, selected: true}, text("("), str(`"Hello, %s! (x%d)"`), text(", name, times)")},
+		{text("}")},
+		prompt("git diff", false),
+		{bold("", "diff --git a/main.go b/main.go")},
+		{bold("", "index 3b18e51..a8c4f2d 100644")},
+		{bold("", "--- a/main.go")},
+		{bold("", "+++ b/main.go")},
+		{with(RoleCyan, "@@ -8,4 +8,4 @@"), text(" func greet(name string, times int) string {")},
+		{text("         return \"\"")},
+		{text("     }")},
+		{with(RoleRed, `-    return fmt.Sprintf("Hello, %s! (x%d)", name, times)`)},
+		{with(RoleGreen, `+    return fmt.Sprintf("Hi, %s! (x%d)", name, times)`)},
+		{text(" }")},
+		prompt("ls --color", false),
+		{bold(RoleBlue, "bin"), text("  "), bold(RoleBlue, "docs"), text("  "), text("go.mod"), text("  "), bold(RoleGreen, "hello"), text("  "),
+			bold(RoleCyan, "latest"), text("  "), with(RoleMagenta, "logo.png"), text("  "), text("main.go"), text("  "), bold(RoleRed, "release.tar.gz")},
+		prompt("go test ./...", false),
+		{with(RoleRed, "--- FAIL: TestGreet (0.00s)")},
+		{text("    main_test.go:12: "), with(RoleYellow, "warning:"), text(` greet("Go", 2) = "Hi, Go! (x2)"`)},
+		{bold(RoleRed, "FAIL")},
+		append(prompt("", true), sampleSpan{text: " ", cursor: true}),
+	}
+}
+
+// renderSample renders a sample terminal session using the semantic roles of the palette
+// (see [Palette.ResolvedRoles]), on the background color of the palette.
+func (p *Palette) renderSample(b *strings.Builder, width int) {
+	roles := p.resolvedRoles()
+	if len(roles) == 0 {
+		return
+	}
+	bg, fg := roles[RoleBackground].Value, roles[RoleForeground].Value
+	fill := lipgloss.NewStyle().Background(bg)
+
+	for _, spans := range sampleSession() {
+		var line strings.Builder
+		for _, span := range spans {
+			style := fill.Foreground(fg).Bold(span.bold).Italic(span.italic)
+			if span.role != "" {
+				style = style.Foreground(roles[span.role].Value)
+			}
+			if span.selected {
+				style = style.Background(roles[RoleSelection].Value)
+			}
+			if span.cursor {
+				style = style.Foreground(bg).Background(roles[RoleCursor].Value)
+			}
+			line.WriteString(style.Render(span.text))
+		}
+
+		rendered := ansi.Truncate(line.String(), width, "")
+		b.WriteString(rendered + fill.Render(strings.Repeat(" ", width-ansi.StringWidth(rendered))) + "\n")
+	}
+}
@@ -0,0 +1,144 @@
+package registry
+
+import (
+	"sort"
+	"strings"
+)
+
+const (
+	// MinFuzzyScore is the score below which a scheme is not considered a fuzzy match.
+	MinFuzzyScore = 0.5
+
+	// winnerScore is the minimum score of a fuzzy match to be selected without confirmation.
+	winnerScore = 0.75
+
+	// winnerMargin is the minimum score difference between the best fuzzy match and the runner-up
+	// for the best one to be selected without confirmation.
+	winnerMargin = 0.1
+)
+
+// Match is a scheme found by a fuzzy search, with its relevance score.
+type Match struct {
+	Scheme ColorScheme
+
+	// Score is the relevance of the scheme, from 0 (unrelated) to 1 (perfect match).
+	Score float64
+}
+
+// FuzzyFind returns the schemes that approximately match a query, best match first.
+//
+// Each scheme is scored by comparing the query to its name, both as a whole and word by word
+// (the words of the name and the scheme families), using the edit distance and subsequence
+// matching, so that typos ("catpucin mocha") and missing spaces ("tokyonight storm") are tolerated.
+// Like [SchemeRegistry.Lookup], the comparison ignores case and accents.
+// Only schemes scoring at least [MinFuzzyScore] are returned.
+func (r *SchemeRegistry) FuzzyFind(query string) []Match {
+	words := strings.Fields(normalizeName(query))
+	if len(words) == 0 {
+		return nil
+	}
+
+	var matches []Match
+	for _, scheme := range r.snapshot() {
+		if score := fuzzyScore(words, scheme); score >= MinFuzzyScore {
+			matches = append(matches, Match{Scheme: scheme, Score: score})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].Scheme.Name() < matches[j].Scheme.Name()
+	})
+	return matches
+}
+
+// ClearWinner returns the best of the ranked fuzzy matches if it is good enough, and clearly
+// better than the runner-up, to be selected without asking the user.
+func ClearWinner(matches []Match) (ColorScheme, bool) {
+	if len(matches) == 0 || matches[0].Score < winnerScore {
+		return nil, false
+	}
+	if len(matches) > 1 && matches[0].Score-matches[1].Score < winnerMargin {
+		return nil, false
+	}
+	return matches[0].Scheme, true
+}
+
+// fuzzyScore rates how well the query words match a scheme, from 0 to 1.
+func fuzzyScore(words []string, scheme ColorScheme) float64 {
+	nameWords := strings.Fields(normalizeName(scheme.Name()))
+	query, name := strings.Join(words, ""), strings.Join(nameWords, "")
+
+	// Whole-name comparison, ignoring spaces
+	score := max(similarity(query, name), subsequenceScore(query, name))
+
+	// Word-by-word comparison: each query word is matched to the closest name word or family
+	targets := nameWords
+	for _, family := range scheme.Families() {
+		targets = append(targets, strings.Fields(normalizeName(family))...)
+	}
+
+	var total float64
+	for _, word := range words {
+		var best float64
+		for _, target := range targets {
+			best = max(best, similarity(word, target))
+		}
+		total += best
+	}
+	return max(score, total/float64(len(words)))
+}
+
+// similarity returns 1 minus the edit distance between a and b, relative to the longest of the two.
+func similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+// subsequenceScore rates the query as an abbreviation of the target: it is 0 unless all the
+// characters of the query appear in the target in order, and grows with the share of the target covered.
+func subsequenceScore(query, target string) float64 {
+	rq, rt := []rune(query), []rune(target)
+	if len(rq) == 0 || len(rt) == 0 {
+		return 0
+	}
+
+	i := 0
+	for _, r := range rt {
+		if i < len(rq) && rq[i] == r {
+			i++
+		}
+	}
+	if i < len(rq) {
+		return 0
+	}
+	return 0.5 + 0.5*float64(len(rq))/float64(len(rt))
+}
+
+// levenshtein returns the edit distance (insertions, deletions and substitutions) between a and b.
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := range a {
+		curr[0] = i + 1
+		for j := range b {
+			cost := 1
+			if a[i] == b[j] {
+				cost = 0
+			}
+			curr[j+1] = min(prev[j+1]+1, curr[j]+1, prev[j]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
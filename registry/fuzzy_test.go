@@ -0,0 +1,74 @@
+package registry_test
+
+import (
+	"testing"
+
+	"github.com/dr8co/palettes/registry"
+)
+
+func newFuzzyRegistry() *registry.SchemeRegistry {
+	reg := registry.NewSchemeRegistry()
+	for _, scheme := range []fakeScheme{
+		{name: "Catppuccin Mocha", families: []string{"catppuccin", "dark"}},
+		{name: "Catppuccin Macchiato", families: []string{"catppuccin", "dark"}},
+		{name: "Catppuccin Latte", families: []string{"catppuccin", "light"}},
+		{name: "Tokyo Night Storm", families: []string{"tokyo night", "dark"}},
+		{name: "Tokyo Night Day", families: []string{"tokyo night", "light"}},
+		{name: "Gruvbox Dark", families: []string{"gruvbox", "dark"}},
+		{name: "Gruvbox Light", families: []string{"gruvbox", "light"}},
+	} {
+		reg.Register(scheme)
+	}
+	return reg
+}
+
+func TestFuzzyFindWinner(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{query: "catpucin mocha", want: "Catppuccin Mocha"},
+		{query: "tokyonight storm", want: "Tokyo Night Storm"},
+		{query: "TOKYO NIGHT DAY", want: "Tokyo Night Day"},
+		{query: "macchiatto", want: "Catppuccin Macchiato"},
+		{query: "gruvbox drk", want: "Gruvbox Dark"},
+	}
+
+	reg := newFuzzyRegistry()
+	for _, tt := range tests {
+		matches := reg.FuzzyFind(tt.query)
+		winner, ok := registry.ClearWinner(matches)
+		if !ok {
+			t.Errorf("FuzzyFind(%q) has no clear winner: %v", tt.query, matches)
+			continue
+		}
+		if winner.Name() != tt.want {
+			t.Errorf("FuzzyFind(%q) winner = %q, want %q", tt.query, winner.Name(), tt.want)
+		}
+	}
+}
+
+func TestFuzzyFindSuggestions(t *testing.T) {
+	t.Parallel()
+
+	reg := newFuzzyRegistry()
+
+	matches := reg.FuzzyFind("gruvbx")
+	if _, ok := registry.ClearWinner(matches); ok {
+		t.Fatalf("FuzzyFind(gruvbx) has a clear winner, want suggestions: %v", matches)
+	}
+	if len(matches) < 2 || matches[0].Scheme.Name() != "Gruvbox Dark" || matches[1].Scheme.Name() != "Gruvbox Light" {
+		t.Errorf("FuzzyFind(gruvbx) = %v, want both Gruvbox variants first", matches)
+	}
+	for i := 1; i < len(matches); i++ {
+		if matches[i].Score > matches[i-1].Score {
+			t.Errorf("FuzzyFind(gruvbx) is not ranked: %v", matches)
+		}
+	}
+
+	if matches := reg.FuzzyFind("xyz"); len(matches) != 0 {
+		t.Errorf("FuzzyFind(xyz) = %v, want no matches", matches)
+	}
+}
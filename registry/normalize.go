@@ -0,0 +1,37 @@
+package registry
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// normalizeName prepares a scheme name, family or query for comparison: accents are removed
+// ("Rosé" becomes "rose"), letters are case-folded, and runs of whitespace, hyphens and
+// underscores are collapsed to a single space.
+func normalizeName(s string) string {
+	// Transformers and casers keep state, so they are not shared between goroutines
+	stripMarks := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	if stripped, _, err := transform.String(stripMarks, s); err == nil {
+		s = stripped
+	}
+	s = cases.Fold().String(s)
+
+	return strings.Join(strings.FieldsFunc(s, isSeparator), " ")
+}
+
+// NameKey returns the normalized form of a name without any separator, so that
+// "rose-pine-moon", "rosepine moon" and "Rosé Pine Moon" share the same key.
+// The registry compares scheme names and families by their keys.
+func NameKey(s string) string {
+	return strings.ReplaceAll(normalizeName(s), " ", "")
+}
+
+// isSeparator reports whether r separates the words of a name.
+func isSeparator(r rune) bool {
+	return unicode.IsSpace(r) || r == '-' || r == '_'
+}
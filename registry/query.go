@@ -0,0 +1,345 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidQuery is returned when a family query cannot be parsed.
+var ErrInvalidQuery = errors.New("invalid family query")
+
+// Query is a boolean expression over scheme families, such as
+// "dark & pastel & !catppuccin" or "(nord | gruvbox) & !light".
+//
+// The operators are '&' (AND), '|' (OR) and '!' (NOT), from the highest to the lowest precedence
+// '!', '&' and '|'; parentheses group sub-expressions. Doubled operators ("&&", "||") are accepted too.
+// Family names may contain spaces ("tokyo night") and, like the registry lookups, are compared
+// ignoring case, accents and separators.
+type Query struct {
+	root queryNode
+}
+
+// ParseQuery parses a family query. Errors wrap [ErrInvalidQuery] and point to the
+// position (in characters, starting at 1) of the problem.
+func ParseQuery(query string) (*Query, error) {
+	p := &queryParser{src: query, tokens: tokenize(query)}
+	if p.peek().kind == tokenEnd {
+		return nil, fmt.Errorf("%w: the query is empty", ErrInvalidQuery)
+	}
+
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEnd {
+		if tok.kind == tokenRParen {
+			return nil, p.errorf(tok, "unexpected ')' without a matching '('")
+		}
+		return nil, p.errorf(tok, "expected '&' or '|' before %s", tok)
+	}
+	return &Query{root: root}, nil
+}
+
+// IsQuery reports whether a string uses the query operators, as opposed to being a plain name.
+func IsQuery(s string) bool {
+	return strings.ContainsAny(s, "&|!()")
+}
+
+// Match reports whether the families of a scheme satisfy the query.
+func (q *Query) Match(scheme ColorScheme) bool {
+	families := make(map[string]bool, len(scheme.Families()))
+	for _, family := range scheme.Families() {
+		families[NameKey(family)] = true
+	}
+	return q.root.match(families)
+}
+
+// Families returns the family names used in the query, in order of appearance.
+func (q *Query) Families() []string {
+	var families []string
+	q.root.walk(func(name string) { families = append(families, name) })
+	return families
+}
+
+// String returns the query in canonical form, with parentheses only where required.
+func (q *Query) String() string {
+	return q.root.String()
+}
+
+// Filter returns all schemes matching a query, sorted by name.
+func (r *SchemeRegistry) Filter(q *Query) []ColorScheme {
+	var matches []ColorScheme
+	for _, scheme := range r.snapshot() {
+		if q.Match(scheme) {
+			matches = append(matches, scheme)
+		}
+	}
+	return sortedByName(matches)
+}
+
+// HasFamily reports whether any registered scheme belongs to a family
+// (ignoring case, accents and separators).
+func (r *SchemeRegistry) HasFamily(family string) bool {
+	key := NameKey(family)
+	for _, scheme := range r.snapshot() {
+		for _, schemeFamily := range scheme.Families() {
+			if NameKey(schemeFamily) == key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// queryNode is a node of a parsed query.
+type queryNode interface {
+	// match evaluates the node against a set of normalized family names.
+	match(families map[string]bool) bool
+
+	// walk calls fn with each family name of the node.
+	walk(fn func(name string))
+
+	String() string
+}
+
+// familyNode matches schemes of a family.
+type familyNode struct {
+	name string
+}
+
+func (n familyNode) match(families map[string]bool) bool { return families[NameKey(n.name)] }
+func (n familyNode) walk(fn func(name string))           { fn(n.name) }
+func (n familyNode) String() string                      { return n.name }
+
+// notNode negates its operand.
+type notNode struct {
+	operand queryNode
+}
+
+func (n notNode) match(families map[string]bool) bool { return !n.operand.match(families) }
+func (n notNode) walk(fn func(name string))           { n.operand.walk(fn) }
+
+func (n notNode) String() string {
+	switch n.operand.(type) {
+	case andNode, orNode:
+		return "!(" + n.operand.String() + ")"
+	default:
+		return "!" + n.operand.String()
+	}
+}
+
+// andNode matches schemes matching both operands.
+type andNode struct {
+	left, right queryNode
+}
+
+func (n andNode) match(families map[string]bool) bool {
+	return n.left.match(families) && n.right.match(families)
+}
+
+func (n andNode) walk(fn func(name string)) {
+	n.left.walk(fn)
+	n.right.walk(fn)
+}
+
+func (n andNode) String() string {
+	return groupOr(n.left) + " & " + groupOr(n.right)
+}
+
+// orNode matches schemes matching either operand.
+type orNode struct {
+	left, right queryNode
+}
+
+func (n orNode) match(families map[string]bool) bool {
+	return n.left.match(families) || n.right.match(families)
+}
+
+func (n orNode) walk(fn func(name string)) {
+	n.left.walk(fn)
+	n.right.walk(fn)
+}
+
+func (n orNode) String() string {
+	return n.left.String() + " | " + n.right.String()
+}
+
+// groupOr wraps an OR node in parentheses, as the operand of an AND.
+func groupOr(n queryNode) string {
+	if _, ok := n.(orNode); ok {
+		return "(" + n.String() + ")"
+	}
+	return n.String()
+}
+
+// tokenKind is the kind of a query token.
+type tokenKind int
+
+const (
+	tokenEnd tokenKind = iota
+	tokenFamily
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenLParen
+	tokenRParen
+)
+
+// token is a lexical element of a query.
+type token struct {
+	kind tokenKind
+	text string
+	pos  int // position of the first character, starting at 1
+}
+
+// String describes a token for error messages.
+func (t token) String() string {
+	if t.kind == tokenEnd {
+		return "the end of the query"
+	}
+	return fmt.Sprintf("'%s'", t.text)
+}
+
+// operators maps the operator characters to their token kind.
+var operators = map[rune]tokenKind{
+	'&': tokenAnd,
+	'|': tokenOr,
+	'!': tokenNot,
+	'(': tokenLParen,
+	')': tokenRParen,
+}
+
+// tokenize splits a query into tokens, always ending with a [tokenEnd] token.
+// Family names extend up to the next operator, with surrounding spaces trimmed.
+func tokenize(query string) []token {
+	var tokens []token
+	runes := []rune(query)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch kind, isOperator := operators[r]; {
+		case unicode.IsSpace(r):
+			i++
+		case isOperator:
+			text := string(r)
+			// Accept "&&" and "||" as well
+			if (kind == tokenAnd || kind == tokenOr) && i+1 < len(runes) && runes[i+1] == r {
+				text += string(r)
+			}
+			tokens = append(tokens, token{kind: kind, text: text, pos: i + 1})
+			i += len([]rune(text))
+		default:
+			start := i
+			for i < len(runes) {
+				if _, isOperator := operators[runes[i]]; isOperator {
+					break
+				}
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenFamily, text: strings.TrimSpace(string(runes[start:i])), pos: start + 1})
+		}
+	}
+
+	return append(tokens, token{kind: tokenEnd, pos: len(runes) + 1})
+}
+
+// queryParser is a recursive descent parser of family queries.
+type queryParser struct {
+	src    string
+	tokens []token
+	next   int
+}
+
+// peek returns the next token without consuming it.
+func (p *queryParser) peek() token {
+	return p.tokens[p.next]
+}
+
+// advance consumes and returns the next token.
+func (p *queryParser) advance() token {
+	tok := p.tokens[p.next]
+	if tok.kind != tokenEnd {
+		p.next++
+	}
+	return tok
+}
+
+// parseOr parses: and ('|' and)*
+func (p *queryParser) parseOr() (queryNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenOr {
+		p.advance()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseAnd parses: unary ('&' unary)*
+func (p *queryParser) parseAnd() (queryNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenAnd {
+		p.advance()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseUnary parses: '!' unary | '(' or ')' | family
+func (p *queryParser) parseUnary() (queryNode, error) {
+	tok := p.advance()
+	switch tok.kind {
+	case tokenFamily:
+		return familyNode{name: tok.text}, nil
+
+	case tokenNot:
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand: operand}, nil
+
+	case tokenLParen:
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.peek(); closing.kind != tokenRParen {
+			if closing.kind == tokenEnd {
+				return nil, p.errorf(tok, "missing ')' for this '('")
+			}
+			return nil, p.errorf(closing, "expected '&', '|' or ')' before %s", closing)
+		}
+		p.advance()
+		return inner, nil
+
+	case tokenRParen:
+		if p.next > 1 && p.tokens[p.next-2].kind == tokenLParen {
+			return nil, p.errorf(tok, "empty parentheses")
+		}
+		return nil, p.errorf(tok, "expected a family name before ')'")
+
+	default:
+		return nil, p.errorf(tok, "expected a family name, '!' or '(', found %s", tok)
+	}
+}
+
+// errorf returns an error wrapping [ErrInvalidQuery], located at a token.
+func (p *queryParser) errorf(tok token, format string, args ...any) error {
+	return fmt.Errorf("%w '%s': %s (at position %d)", ErrInvalidQuery, p.src, fmt.Sprintf(format, args...), tok.pos)
+}
@@ -0,0 +1,90 @@
+package registry_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dr8co/palettes/registry"
+)
+
+func TestQueryFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "dark & !catppuccin", want: []string{"Gruvbox Dark", "Tokyo Night Storm"}},
+		{query: "gruvbox | tokyo night", want: []string{"Gruvbox Dark", "Gruvbox Light", "Tokyo Night Day", "Tokyo Night Storm"}},
+		{query: "(gruvbox || Tokyo-Night) && !light", want: []string{"Gruvbox Dark", "Tokyo Night Storm"}},
+		{query: "light | gruvbox & dark", want: []string{"Catppuccin Latte", "Gruvbox Dark", "Gruvbox Light", "Tokyo Night Day"}},
+		{query: "!!catppuccin & light", want: []string{"Catppuccin Latte"}},
+		{query: "unknown", want: nil},
+	}
+
+	reg := newFuzzyRegistry()
+	for _, tt := range tests {
+		q, err := registry.ParseQuery(tt.query)
+		if err != nil {
+			t.Errorf("ParseQuery(%q) error = %v", tt.query, err)
+			continue
+		}
+
+		var got []string
+		for _, scheme := range reg.Filter(q) {
+			got = append(got, scheme.Name())
+		}
+		if strings.Join(got, ", ") != strings.Join(tt.want, ", ") {
+			t.Errorf("Filter(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestQueryString(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"dark&pastel&!catppuccin":   "dark & pastel & !catppuccin",
+		"(nord||gruvbox) && !light": "(nord | gruvbox) & !light",
+		"!( a | b )":                "!(a | b)",
+		"((dark))":                  "dark",
+	}
+
+	for query, want := range tests {
+		q, err := registry.ParseQuery(query)
+		if err != nil {
+			t.Errorf("ParseQuery(%q) error = %v", query, err)
+			continue
+		}
+		if got := q.String(); got != want {
+			t.Errorf("ParseQuery(%q).String() = %q, want %q", query, got, want)
+		}
+	}
+}
+
+func TestParseQueryErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"":            "the query is empty",
+		"dark &":      "expected a family name, '!' or '(', found the end of the query (at position 7)",
+		"| nord":      "expected a family name, '!' or '(', found '|' (at position 1)",
+		"(dark":       "missing ')' for this '(' (at position 1)",
+		"dark)":       "unexpected ')' without a matching '(' (at position 5)",
+		"()":          "empty parentheses (at position 2)",
+		"(dark) nord": "expected '&' or '|' before 'nord' (at position 8)",
+		"(dark nord":  "missing ')' for this '(' (at position 1)",
+	}
+
+	for query, want := range tests {
+		_, err := registry.ParseQuery(query)
+		if !errors.Is(err, registry.ErrInvalidQuery) {
+			t.Errorf("ParseQuery(%q) error = %v, want ErrInvalidQuery", query, err)
+			continue
+		}
+		if !strings.HasSuffix(err.Error(), want) {
+			t.Errorf("ParseQuery(%q) error = %q, want it to end with %q", query, err, want)
+		}
+	}
+}
@@ -4,17 +4,19 @@
 //
 // The registry package serves as the organizational core for managing multiple color schemes,
 // providing features such as:
-//   - Registration and retrieval of color schemes
-//   - Case-sensitive and partial name matching
-//   - Family-based grouping and filtering
+//   - Registration and retrieval of color schemes, safe for concurrent use
+//   - Duplicate detection, removal and replacement of color schemes
+//   - Exact, accent- and case-insensitive, partial and fuzzy (typo-tolerant, ranked) name matching
+//   - Family-based grouping and filtering, with boolean family queries (see [Query])
 //   - Sorted listing of available schemes
 //   - Bulk operations on registered schemes
 //
 // Each color scheme in the registry must implement the [ColorScheme] interface, which
 // defines the basic operations that any color scheme should support:
 //   - Getting the scheme's name
-//   - Displaying the scheme in the terminal
+//   - Displaying the scheme in the terminal, or rendering it to any writer
 //   - Retrieving the scheme's family associations
+//   - Retrieving the scheme's colors, semantic roles and attribution metadata
 //
 // Example usage:
 //
@@ -28,25 +30,34 @@
 package registry
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"sort"
 	"strings"
+	"sync"
+
+	"github.com/charmbracelet/colorprofile"
+	"github.com/dr8co/palettes/scheme"
 )
 
-// ColorScheme is re-exported from the palette package to maintain compatibility.
-type ColorScheme interface {
-	// Name returns the name of the color scheme.
-	Name() string
+var (
+	// ErrDuplicateScheme is returned when registering a scheme whose name is already taken.
+	ErrDuplicateScheme = errors.New("palette already registered")
 
-	// Show displays the color scheme.
-	Show()
+	// ErrSchemeNotFound is returned when a scheme is not registered.
+	ErrSchemeNotFound = errors.New("palette not found")
+)
 
-	// Families returns the color families.
-	Families() []string
-}
+// ColorScheme is the interface of the registered color schemes, re-exported from the
+// dependency-free scheme package so that registry results expose their colors, roles and metadata.
+type ColorScheme = scheme.ColorScheme
 
 // SchemeRegistry manages available color schemes.
+// It is safe for concurrent use by multiple goroutines.
 type SchemeRegistry struct {
+	mu      sync.RWMutex
 	schemes map[string]ColorScheme
 }
 
@@ -58,18 +69,103 @@ func NewSchemeRegistry() *SchemeRegistry {
 }
 
 // Register adds a color scheme to the registry.
+// A scheme already registered under the exact same name is overwritten;
+// use [SchemeRegistry.RegisterUnique] to detect name collisions instead.
 func (r *SchemeRegistry) Register(scheme ColorScheme) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	r.schemes[scheme.Name()] = scheme
 }
 
+// RegisterUnique adds a color scheme to the registry, unless a scheme with an equivalent
+// name (ignoring case, accents and separators, see [SchemeRegistry.Lookup]) is already registered, in which case an error
+// wrapping [ErrDuplicateScheme] is returned.
+func (r *SchemeRegistry) RegisterUnique(scheme ColorScheme) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, ok := r.lookupFold(scheme.Name()); ok {
+		return fmt.Errorf("%w: %s (conflicts with %s)", ErrDuplicateScheme, scheme.Name(), existing)
+	}
+	r.schemes[scheme.Name()] = scheme
+	return nil
+}
+
+// Replace swaps the registered scheme having an equivalent name to the given one (ignoring
+// case, accents and separators) for the given scheme. It returns an error wrapping [ErrSchemeNotFound]
+// if no such scheme is registered.
+func (r *SchemeRegistry) Replace(scheme ColorScheme) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.lookupFold(scheme.Name())
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrSchemeNotFound, scheme.Name())
+	}
+	delete(r.schemes, existing)
+	r.schemes[scheme.Name()] = scheme
+	return nil
+}
+
+// Upsert adds a color scheme to the registry, replacing the registered scheme having an equivalent
+// name (ignoring case, accents and separators) if there is one, as a single atomic operation.
+// It reports whether a scheme was replaced.
+func (r *SchemeRegistry) Upsert(scheme ColorScheme) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, replaced := r.lookupFold(scheme.Name())
+	if replaced {
+		delete(r.schemes, existing)
+	}
+	r.schemes[scheme.Name()] = scheme
+	return replaced
+}
+
+// Unregister removes a color scheme by name, ignoring case, accents and separators
+// like [SchemeRegistry.Replace]. It reports whether the scheme was registered.
+func (r *SchemeRegistry) Unregister(name string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	registered, exists := r.lookupFold(name)
+	if exists {
+		delete(r.schemes, registered)
+	}
+	return exists
+}
+
 // Get retrieves a color scheme by name (case-sensitive).
 func (r *SchemeRegistry) Get(name string) (ColorScheme, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	scheme, exists := r.schemes[name]
 	return scheme, exists
 }
 
+// Lookup retrieves a color scheme by name, ignoring case, accents and separators,
+// so that "rose-pine-moon", "rosepine moon" and "Rosé Pine Moon" find the same scheme.
+// An exact (case-sensitive) match takes precedence.
+func (r *SchemeRegistry) Lookup(name string) (ColorScheme, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if scheme, exists := r.schemes[name]; exists {
+		return scheme, true
+	}
+	if registered, ok := r.lookupFold(name); ok {
+		return r.schemes[registered], true
+	}
+	return nil, false
+}
+
 // List returns all registered scheme names, sorted alphabetically.
 func (r *SchemeRegistry) List() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	names := make([]string, 0, len(r.schemes))
 	for name := range r.schemes {
 		names = append(names, name)
@@ -79,70 +175,86 @@ func (r *SchemeRegistry) List() []string {
 	return names
 }
 
-// Show displays a specific color scheme by name.
+// Show displays a specific color scheme by name on the standard output,
+// downsampling the colors to the profile of the terminal.
 func (r *SchemeRegistry) Show(name string) error {
+	return r.Render(stdout(), name)
+}
+
+// Render writes a specific color scheme, looked up by name, to w.
+func (r *SchemeRegistry) Render(w io.Writer, name string) error {
 	scheme, exists := r.Get(name)
 	if !exists {
-		return fmt.Errorf("palette %s not found", name)
+		return fmt.Errorf("%w: %s", ErrSchemeNotFound, name)
+	}
+	if _, err := scheme.WriteTo(w); err != nil {
+		return fmt.Errorf("rendering palette %s: %w", name, err)
 	}
-	scheme.Show()
 	return nil
 }
 
-// ShowAll displays all registered color schemes.
+// ShowAll displays all registered color schemes on the standard output,
+// downsampling the colors to the profile of the terminal.
 func (r *SchemeRegistry) ShowAll() {
-	for _, name := range r.List() {
-		scheme, _ := r.Get(name)
-		scheme.Show()
+	_, _ = r.WriteTo(stdout())
+}
+
+// stdout returns a writer to the standard output that downsamples colors to the profile
+// detected from the terminal and the environment, and strips them if NO_COLOR is set.
+func stdout() io.Writer {
+	return colorprofile.NewWriter(os.Stdout, os.Environ())
+}
+
+// WriteTo writes all registered color schemes to w, sorted by name.
+// It implements the [io.WriterTo] interface.
+func (r *SchemeRegistry) WriteTo(w io.Writer) (int64, error) {
+	var total int64
+	for _, scheme := range sortedByName(r.snapshot()) {
+		n, err := scheme.WriteTo(w)
+		total += n
+		if err != nil {
+			return total, fmt.Errorf("rendering palette %s: %w", scheme.Name(), err)
+		}
 	}
+	return total, nil
 }
 
 // FindByFamily returns all schemes belonging to a specific family.
 func (r *SchemeRegistry) FindByFamily(family string) []ColorScheme {
-	family = strings.ToLower(family)
+	family = NameKey(family)
 	var matches []ColorScheme
 
-	for _, scheme := range r.schemes {
+	for _, scheme := range r.snapshot() {
 		for _, schemeFamily := range scheme.Families() {
-			if strings.ToLower(schemeFamily) == family {
+			if NameKey(schemeFamily) == family {
 				matches = append(matches, scheme)
 				break // Don't add the same scheme multiple times
 			}
 		}
 	}
 
-	// Sort matches by name for a consistent output
-	sort.Slice(matches, func(i, j int) bool {
-		return matches[i].Name() < matches[j].Name()
-	})
-
-	return matches
+	return sortedByName(matches)
 }
 
 // FindByPartialName returns all schemes whose names contain the given substring.
 func (r *SchemeRegistry) FindByPartialName(partial string) []ColorScheme {
-	partial = strings.ToLower(partial)
+	partial = NameKey(partial)
 	var matches []ColorScheme
 
-	for _, scheme := range r.schemes {
-		if strings.Contains(strings.ToLower(scheme.Name()), partial) {
+	for _, scheme := range r.snapshot() {
+		if strings.Contains(NameKey(scheme.Name()), partial) {
 			matches = append(matches, scheme)
 		}
 	}
 
-	// Sort matches by name for a consistent output
-	sort.Slice(matches, func(i, j int) bool {
-		return matches[i].Name() < matches[j].Name()
-	})
-
-	return matches
+	return sortedByName(matches)
 }
 
 // GetFamilies returns all unique family names across all schemes.
 func (r *SchemeRegistry) GetFamilies() []string {
 	familySet := make(map[string]bool)
 
-	for _, scheme := range r.schemes {
+	for _, scheme := range r.snapshot() {
 		for _, family := range scheme.Families() {
 			if family != "" {
 				familySet[family] = true
@@ -167,18 +279,18 @@ func (r *SchemeRegistry) GetSchemesByFamilies(families []string) []ColorScheme {
 
 	var matches []ColorScheme
 
-	for _, scheme := range r.schemes {
+	for _, scheme := range r.snapshot() {
 		hasAllFamilies := true
 		schemeFamilies := make(map[string]bool)
 
 		// Build a set of scheme families for a quick lookup
 		for _, family := range scheme.Families() {
-			schemeFamilies[strings.ToLower(family)] = true
+			schemeFamilies[NameKey(family)] = true
 		}
 
 		// Check if a scheme has all required families
 		for _, requiredFamily := range families {
-			if !schemeFamilies[strings.ToLower(requiredFamily)] {
+			if !schemeFamilies[NameKey(requiredFamily)] {
 				hasAllFamilies = false
 				break
 			}
@@ -189,10 +301,37 @@ func (r *SchemeRegistry) GetSchemesByFamilies(families []string) []ColorScheme {
 		}
 	}
 
-	// Sort matches by name for a consistent output
-	sort.Slice(matches, func(i, j int) bool {
-		return matches[i].Name() < matches[j].Name()
+	return sortedByName(matches)
+}
+
+// snapshot returns the registered schemes, so that they can be inspected without holding the lock.
+func (r *SchemeRegistry) snapshot() []ColorScheme {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	schemes := make([]ColorScheme, 0, len(r.schemes))
+	for _, scheme := range r.schemes {
+		schemes = append(schemes, scheme)
+	}
+	return schemes
+}
+
+// sortedByName sorts schemes by name in place, for a consistent output, and returns them.
+func sortedByName(schemes []ColorScheme) []ColorScheme {
+	sort.Slice(schemes, func(i, j int) bool {
+		return schemes[i].Name() < schemes[j].Name()
 	})
+	return schemes
+}
 
-	return matches
+// lookupFold returns the registered name equivalent to name, ignoring case, accents and separators.
+// The caller must hold the lock.
+func (r *SchemeRegistry) lookupFold(name string) (string, bool) {
+	key := NameKey(name)
+	for registered := range r.schemes {
+		if NameKey(registered) == key {
+			return registered, true
+		}
+	}
+	return "", false
 }
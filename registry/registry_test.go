@@ -0,0 +1,173 @@
+package registry_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/dr8co/palettes/registry"
+	"github.com/dr8co/palettes/scheme"
+)
+
+// fakeScheme is a minimal color scheme for registry tests.
+type fakeScheme struct {
+	name     string
+	families []string
+}
+
+func (s fakeScheme) Name() string       { return s.name }
+func (s fakeScheme) Show()              {}
+func (s fakeScheme) Families() []string { return s.families }
+
+func (s fakeScheme) Metadata() scheme.Metadata { return scheme.Metadata{} }
+
+func (s fakeScheme) Definitions() []scheme.ColorDefinition { return nil }
+
+func (s fakeScheme) RoleColors() map[scheme.Role]scheme.ColorDefinition { return nil }
+
+func (s fakeScheme) WriteTo(w io.Writer) (int64, error) {
+	n, err := fmt.Fprintln(w, s.name)
+	return int64(n), err
+}
+
+func TestRegisterUnique(t *testing.T) {
+	reg := registry.NewSchemeRegistry()
+	if err := reg.RegisterUnique(fakeScheme{name: "Nord"}); err != nil {
+		t.Fatalf("RegisterUnique() error = %v", err)
+	}
+
+	err := reg.RegisterUnique(fakeScheme{name: "NORD"})
+	if !errors.Is(err, registry.ErrDuplicateScheme) {
+		t.Fatalf("RegisterUnique() of a case-insensitive duplicate: error = %v, want ErrDuplicateScheme", err)
+	}
+	if got := reg.List(); len(got) != 1 || got[0] != "Nord" {
+		t.Errorf("List() = %v, want [Nord]", got)
+	}
+}
+
+func TestReplace(t *testing.T) {
+	reg := registry.NewSchemeRegistry()
+	reg.Register(fakeScheme{name: "Nord", families: []string{"old"}})
+
+	if err := reg.Replace(fakeScheme{name: "nord", families: []string{"new"}}); err != nil {
+		t.Fatalf("Replace() error = %v", err)
+	}
+	if got := reg.List(); len(got) != 1 || got[0] != "nord" {
+		t.Errorf("List() = %v, want [nord]", got)
+	}
+	if got := reg.FindByFamily("new"); len(got) != 1 {
+		t.Errorf("FindByFamily(new) = %v, want the replacement", got)
+	}
+
+	if err := reg.Replace(fakeScheme{name: "Dracula"}); !errors.Is(err, registry.ErrSchemeNotFound) {
+		t.Errorf("Replace() of an unknown scheme: error = %v, want ErrSchemeNotFound", err)
+	}
+}
+
+func TestUpsert(t *testing.T) {
+	reg := registry.NewSchemeRegistry()
+	reg.Register(fakeScheme{name: "Rosé Pine", families: []string{"old"}})
+
+	if !reg.Upsert(fakeScheme{name: "rose-pine", families: []string{"new"}}) {
+		t.Error("Upsert() of an equivalent name = false, want true")
+	}
+	if got := reg.List(); len(got) != 1 || got[0] != "rose-pine" {
+		t.Errorf("List() = %v, want [rose-pine]", got)
+	}
+	if got := reg.FindByFamily("new"); len(got) != 1 {
+		t.Errorf("FindByFamily(new) = %v, want the replacement", got)
+	}
+
+	if reg.Upsert(fakeScheme{name: "Dracula"}) {
+		t.Error("Upsert() of a new scheme = true, want false")
+	}
+	if got := reg.List(); len(got) != 2 {
+		t.Errorf("List() = %v, want 2 schemes", got)
+	}
+}
+
+func TestUnregister(t *testing.T) {
+	reg := registry.NewSchemeRegistry()
+	reg.Register(fakeScheme{name: "Nord"})
+
+	if !reg.Unregister("Nord") {
+		t.Error("Unregister(Nord) = false, want true")
+	}
+	if reg.Unregister("Nord") {
+		t.Error("second Unregister(Nord) = true, want false")
+	}
+	if err := reg.Render(io.Discard, "Nord"); !errors.Is(err, registry.ErrSchemeNotFound) {
+		t.Errorf("Render() of an unregistered scheme: error = %v, want ErrSchemeNotFound", err)
+	}
+
+	// Names are matched like Replace and Upsert match them
+	reg.Register(fakeScheme{name: "Rosé Pine Moon"})
+	reg.Upsert(fakeScheme{name: "rose-pine moon"})
+	if !reg.Unregister("ROSE PINE MOON") {
+		t.Error("Unregister(ROSE PINE MOON) = false, want true")
+	}
+	if got := reg.List(); len(got) != 0 {
+		t.Errorf("List() = %v after Unregister, want no schemes", got)
+	}
+}
+
+func TestConcurrentAccess(t *testing.T) {
+	reg := registry.NewSchemeRegistry()
+
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			name := fmt.Sprintf("Scheme %d", i)
+			_ = reg.RegisterUnique(fakeScheme{name: name, families: []string{"test"}})
+			reg.Unregister(name)
+			reg.Register(fakeScheme{name: name, families: []string{"test"}})
+		}()
+		go func() {
+			defer wg.Done()
+			reg.List()
+			reg.FindByFamily("test")
+			reg.FindByPartialName("scheme")
+			_, _ = reg.WriteTo(io.Discard)
+		}()
+	}
+	wg.Wait()
+
+	if got := len(reg.FindByFamily("test")); got != 50 {
+		t.Errorf("FindByFamily(test) found %d schemes, want 50", got)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	reg := registry.NewSchemeRegistry()
+	reg.Register(fakeScheme{name: "Rosé Pine Moon", families: []string{"Rosé Pine", "dark"}})
+	reg.Register(fakeScheme{name: "Straße", families: []string{"test"}})
+
+	for _, query := range []string{"Rosé Pine Moon", "rose-pine-moon", "rosepine moon", "ROSE_PINE  moon", "rose\tpine\nmoon"} {
+		scheme, ok := reg.Lookup(query)
+		if !ok || scheme.Name() != "Rosé Pine Moon" {
+			t.Errorf("Lookup(%q) = %v, %v, want Rosé Pine Moon", query, scheme, ok)
+		}
+	}
+	if scheme, ok := reg.Lookup("STRASSE"); !ok || scheme.Name() != "Straße" {
+		t.Errorf("Lookup(STRASSE) = %v, %v, want Straße", scheme, ok)
+	}
+	if _, ok := reg.Lookup("rose pine"); ok {
+		t.Error("Lookup(rose pine) found a scheme, want none")
+	}
+
+	if got := reg.FindByPartialName("rose pine"); len(got) != 1 {
+		t.Errorf("FindByPartialName(rose pine) = %v, want Rosé Pine Moon", got)
+	}
+	if got := reg.FindByFamily("rose-pine"); len(got) != 1 {
+		t.Errorf("FindByFamily(rose-pine) = %v, want Rosé Pine Moon", got)
+	}
+	if err := reg.RegisterUnique(fakeScheme{name: "Rose Pine Moon"}); !errors.Is(err, registry.ErrDuplicateScheme) {
+		t.Errorf("RegisterUnique(Rose Pine Moon) error = %v, want ErrDuplicateScheme", err)
+	}
+}
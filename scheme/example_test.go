@@ -0,0 +1,27 @@
+package scheme_test
+
+import (
+	"fmt"
+
+	"github.com/dr8co/palettes/palette"
+	"github.com/dr8co/palettes/scheme"
+)
+
+// Example inspects a color scheme through the ColorScheme interface only.
+func Example() {
+	cs, ok := palette.Default().Lookup("Catppuccin Mocha")
+	if !ok {
+		return
+	}
+
+	for _, color := range cs.Definitions()[:3] {
+		fmt.Println(color.Name, color.Hex)
+	}
+	bg := cs.RoleColors()[scheme.RoleBackground]
+	fmt.Println("background:", bg.Name, bg.Hex)
+	// Output:
+	// Rosewater #f5e0dc
+	// Flamingo #f2cdcd
+	// Pink #f5c2e7
+	// background: Base #1e1e2e
+}
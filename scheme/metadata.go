@@ -0,0 +1,25 @@
+package scheme
+
+// Metadata describes the origin of a color scheme, for attribution.
+// All fields are optional.
+type Metadata struct {
+	// Author is the author or maintainer of the upstream color scheme.
+	Author string `json:"author,omitempty" yaml:"author,omitempty" toml:"author,omitempty"`
+
+	// URL is the homepage or upstream repository of the color scheme.
+	URL string `json:"url,omitempty" yaml:"url,omitempty" toml:"url,omitempty"`
+
+	// License is the license of the upstream color scheme, preferably as an SPDX identifier.
+	License string `json:"license,omitempty" yaml:"license,omitempty" toml:"license,omitempty"`
+
+	// Description is a short description of the color scheme.
+	Description string `json:"description,omitempty" yaml:"description,omitempty" toml:"description,omitempty"`
+
+	// Version is the upstream version the colors were taken from.
+	Version string `json:"version,omitempty" yaml:"version,omitempty" toml:"version,omitempty"`
+}
+
+// IsZero reports whether no metadata is set.
+func (m Metadata) IsZero() bool {
+	return m == Metadata{}
+}
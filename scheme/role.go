@@ -0,0 +1,78 @@
+package scheme
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Role is a semantic color role, shared by all palettes regardless of how their colors are named.
+type Role string
+
+// Semantic color roles.
+const (
+	RoleBackground Role = "bg"
+	RoleForeground Role = "fg"
+	RoleCursor     Role = "cursor"
+	RoleSelection  Role = "selection"
+
+	RoleBlack   Role = "black"
+	RoleRed     Role = "red"
+	RoleGreen   Role = "green"
+	RoleYellow  Role = "yellow"
+	RoleBlue    Role = "blue"
+	RoleMagenta Role = "magenta"
+	RoleCyan    Role = "cyan"
+	RoleWhite   Role = "white"
+
+	RoleBrightBlack   Role = "bright black"
+	RoleBrightRed     Role = "bright red"
+	RoleBrightGreen   Role = "bright green"
+	RoleBrightYellow  Role = "bright yellow"
+	RoleBrightBlue    Role = "bright blue"
+	RoleBrightMagenta Role = "bright magenta"
+	RoleBrightCyan    Role = "bright cyan"
+	RoleBrightWhite   Role = "bright white"
+
+	RoleAccent  Role = "accent"
+	RoleError   Role = "error"
+	RoleWarning Role = "warn"
+	RoleInfo    Role = "info"
+)
+
+// ANSIRoles holds the roles of the 16 ANSI colors, in terminal order:
+// 0-7 are the normal colors and 8-15 their bright variants.
+var ANSIRoles = [16]Role{
+	RoleBlack, RoleRed, RoleGreen, RoleYellow, RoleBlue, RoleMagenta, RoleCyan, RoleWhite,
+	RoleBrightBlack, RoleBrightRed, RoleBrightGreen, RoleBrightYellow,
+	RoleBrightBlue, RoleBrightMagenta, RoleBrightCyan, RoleBrightWhite,
+}
+
+// Roles returns all semantic roles, in canonical order.
+func Roles() []Role {
+	roles := make([]Role, 0, 4+len(ANSIRoles)+4)
+	roles = append(roles, RoleBackground, RoleForeground, RoleCursor, RoleSelection)
+	roles = append(roles, ANSIRoles[:]...)
+	roles = append(roles, RoleAccent, RoleError, RoleWarning, RoleInfo)
+	return roles
+}
+
+// ParseRole converts a role name (case-insensitive) to a [Role].
+// Besides the canonical names, "background", "foreground" and "warning" are accepted.
+func ParseRole(name string) (Role, error) {
+	name = strings.Join(strings.Fields(strings.ToLower(name)), " ")
+	switch name {
+	case "background":
+		return RoleBackground, nil
+	case "foreground":
+		return RoleForeground, nil
+	case "warning":
+		return RoleWarning, nil
+	}
+
+	for _, role := range Roles() {
+		if string(role) == name {
+			return role, nil
+		}
+	}
+	return "", fmt.Errorf("unknown color role '%s'", name)
+}
@@ -0,0 +1,68 @@
+// Package scheme defines the types shared by every color scheme implementation: the
+// [ColorScheme] interface, color definitions, semantic color roles and attribution metadata.
+//
+// It has no dependencies outside the standard library, so that libraries can accept and
+// inspect color schemes without depending on how they are rendered. The [palette] package
+// provides the standard implementation, and the [registry] package stores and looks up
+// color schemes through this interface.
+//
+// Example usage:
+//
+//	cs, ok := reg.Lookup("Catppuccin Mocha")
+//	if ok {
+//		for _, color := range cs.Definitions() {
+//			fmt.Println(color.Name, color.Hex)
+//		}
+//		bg := cs.RoleColors()[scheme.RoleBackground]
+//		fmt.Println("background:", bg.Name, bg.Hex)
+//	}
+//
+// [palette]: https://pkg.go.dev/github.com/dr8co/palettes/palette
+// [registry]: https://pkg.go.dev/github.com/dr8co/palettes/registry
+package scheme
+
+import (
+	"fmt"
+	"io"
+)
+
+// ColorScheme is the interface implemented by all color schemes.
+type ColorScheme interface {
+	// Name returns the name of the color scheme.
+	Name() string
+
+	// Families returns the color families.
+	Families() []string
+
+	// Metadata returns the attribution details of the color scheme.
+	Metadata() Metadata
+
+	// Definitions returns the color definitions, in scheme order.
+	Definitions() []ColorDefinition
+
+	// RoleColors returns the color definition assigned to every semantic role.
+	RoleColors() map[Role]ColorDefinition
+
+	// Show displays the color scheme on the standard output.
+	Show()
+
+	// WriteTo writes the rendering of the color scheme to w.
+	WriteTo(w io.Writer) (int64, error)
+}
+
+// ColorDefinition represents a color name and hex value pair.
+type ColorDefinition struct {
+	// Name is the name of the color.
+	Name string `json:"name" yaml:"name" toml:"name"`
+
+	// Hex is the hex code of the color.
+	Hex string `json:"hex" yaml:"hex" toml:"hex"`
+}
+
+// String returns a string representation of a [ColorDefinition].
+func (cd *ColorDefinition) String() string {
+	if cd.Name == "" {
+		return cd.Hex
+	}
+	return fmt.Sprintf("%s (%s)", cd.Name, cd.Hex)
+}
@@ -0,0 +1,498 @@
+// Package tui provides an interactive, full-screen palette browser built with Bubble Tea.
+//
+// The browser lists the palettes of a registry grouped by family (the first family of each
+// palette), previews the selected palette with the same swatches as [palette.Palette.Render],
+// and supports:
+//   - Keyboard navigation between the palettes and between the colors of a palette
+//   - Live filtering by name or family, or with a family query such as "dark & !catppuccin"
+//   - Copying the hex value of a color to the clipboard (with OSC 52, over SSH too)
+//   - Exporting the selected palette to a file, in any of the [export.Formats]
+//
+// Example usage:
+//
+//	err := tui.Run(palette.Default(), tui.Options{Format: export.FormatKitty})
+package tui
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	"charm.land/bubbles/v2/help"
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/dr8co/palettes/export"
+	"github.com/dr8co/palettes/palette"
+	"github.com/dr8co/palettes/registry"
+)
+
+// listWidth is the width of the palette list, including its separator.
+const listWidth = 34
+
+var (
+	titleStyle    = lipgloss.NewStyle().Bold(true)
+	groupStyle    = lipgloss.NewStyle().Bold(true).Faint(true)
+	selectedStyle = lipgloss.NewStyle().Reverse(true)
+	statusStyle   = lipgloss.NewStyle().Faint(true)
+	errorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Red)
+)
+
+// Options configures the browser.
+type Options struct {
+	// Format is the format of the exported palettes. It defaults to [export.FormatJSON].
+	Format export.Format
+
+	// Dir is the directory the palettes are exported to. It defaults to the current directory.
+	Dir string
+}
+
+// focus identifies the pane receiving the navigation keys.
+type focus int
+
+const (
+	focusList focus = iota
+	focusColors
+)
+
+// Model is the Bubble Tea model of the browser. Create it with [New].
+type Model struct {
+	opts Options
+	keys keyMap
+	help help.Model
+
+	filter  textinput.Model
+	schemes []registry.ColorScheme // all schemes, sorted by group and name
+	visible []registry.ColorScheme // schemes matching the filter
+
+	focus  focus
+	cursor int // index of the selected scheme in visible
+	color  int // index of the selected color in the selected scheme
+
+	width, height int
+	status        string
+	err           bool // whether status is an error
+}
+
+// New returns a browser of the schemes of a registry.
+func New(reg *registry.SchemeRegistry, opts Options) Model {
+	if opts.Format == "" {
+		opts.Format = export.FormatJSON
+	}
+
+	filter := textinput.New()
+	filter.Prompt = "Filter: "
+	filter.Placeholder = "name, family or query (dark & !catppuccin)"
+
+	schemes := make([]registry.ColorScheme, 0, len(reg.List()))
+	for _, name := range reg.List() {
+		if scheme, ok := reg.Get(name); ok {
+			schemes = append(schemes, scheme)
+		}
+	}
+	slices.SortStableFunc(schemes, func(a, b registry.ColorScheme) int {
+		return cmp.Compare(strings.ToLower(group(a)), strings.ToLower(group(b)))
+	})
+
+	return Model{
+		opts:    opts,
+		keys:    defaultKeyMap(),
+		help:    help.New(),
+		filter:  filter,
+		schemes: schemes,
+		visible: schemes,
+	}
+}
+
+// Run starts the browser in the alternate screen and blocks until the user quits.
+func Run(reg *registry.SchemeRegistry, opts Options) error {
+	if _, err := tea.NewProgram(New(reg, opts)).Run(); err != nil {
+		return fmt.Errorf("running the palette browser: %w", err)
+	}
+	return nil
+}
+
+// Selected returns the selected scheme, if any scheme matches the filter.
+func (m Model) Selected() (registry.ColorScheme, bool) {
+	if m.cursor >= len(m.visible) {
+		return nil, false
+	}
+	return m.visible[m.cursor], true
+}
+
+// Init implements the tea.Model interface.
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements the tea.Model interface.
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.help.SetWidth(msg.Width)
+		m.filter.SetWidth(msg.Width - len(m.filter.Prompt) - 1)
+		return m, nil
+
+	case tea.KeyPressMsg:
+		if m.filter.Focused() {
+			return m.updateFilter(msg)
+		}
+		return m.updateKeys(msg)
+	}
+	return m, nil
+}
+
+// updateFilter handles the keys typed in the filter.
+func (m Model) updateFilter(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.filter.Blur()
+		m.filter.Reset()
+		m.applyFilter()
+		return m, nil
+	case "enter", "tab", "up", "down":
+		m.filter.Blur()
+		return m.updateKeys(msg)
+	case "ctrl+c":
+		return m, tea.Quit
+	}
+
+	var cmd tea.Cmd
+	m.filter, cmd = m.filter.Update(msg)
+	m.applyFilter()
+	return m, cmd
+}
+
+// updateKeys handles the navigation and action keys.
+func (m Model) updateKeys(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	m.status, m.err = "", false
+
+	switch {
+	case key.Matches(msg, m.keys.Quit):
+		return m, tea.Quit
+	case key.Matches(msg, m.keys.Filter):
+		m.focus = focusList
+		return m, m.filter.Focus()
+	case key.Matches(msg, m.keys.Clear):
+		m.filter.Reset()
+		m.applyFilter()
+	case key.Matches(msg, m.keys.Help):
+		m.help.ShowAll = !m.help.ShowAll
+	case key.Matches(msg, m.keys.Up):
+		m.move(-1)
+	case key.Matches(msg, m.keys.Down):
+		m.move(1)
+	case key.Matches(msg, m.keys.Top):
+		m.move(-len(m.schemes) - m.colorCount())
+	case key.Matches(msg, m.keys.Bottom):
+		m.move(len(m.schemes) + m.colorCount())
+	case key.Matches(msg, m.keys.Switch):
+		if m.focus == focusList && m.colorCount() > 0 {
+			m.focus = focusColors
+		} else {
+			m.focus = focusList
+		}
+	case key.Matches(msg, m.keys.Copy):
+		return m, m.copyColor()
+	case key.Matches(msg, m.keys.Export):
+		m.exportSelected()
+	}
+	return m, nil
+}
+
+// move moves the selection of the focused pane by delta, within bounds.
+func (m *Model) move(delta int) {
+	if m.focus == focusColors {
+		m.color = clamp(m.color+delta, 0, m.colorCount()-1)
+		return
+	}
+
+	cursor := clamp(m.cursor+delta, 0, len(m.visible)-1)
+	if cursor != m.cursor {
+		m.cursor, m.color = cursor, 0
+	}
+}
+
+// applyFilter updates the visible schemes after a change of the filter, keeping the
+// selected scheme selected if it is still visible.
+func (m *Model) applyFilter() {
+	selected, _ := m.Selected()
+
+	visible, err := filterSchemes(m.schemes, m.filter.Value())
+	if err != nil {
+		// Keep the previous results while a query is being typed
+		m.status, m.err = err.Error(), true
+		return
+	}
+	m.status, m.err = "", false
+	m.visible = visible
+
+	m.cursor, m.color = 0, 0
+	if i := slices.Index(visible, selected); i >= 0 {
+		m.cursor = i
+	}
+	if len(visible) == 0 {
+		m.focus = focusList
+	}
+}
+
+// colorCount returns the number of colors of the selected scheme.
+func (m Model) colorCount() int {
+	scheme, ok := m.Selected()
+	if !ok {
+		return 0
+	}
+	return len(scheme.Definitions())
+}
+
+// copyColor returns the command copying the hex value of the selected color to the clipboard.
+func (m *Model) copyColor() tea.Cmd {
+	scheme, ok := m.Selected()
+	if !ok || m.colorCount() == 0 {
+		return nil
+	}
+
+	def := scheme.Definitions()[m.color]
+	m.status = fmt.Sprintf("Copied %s (%s) to the clipboard", def.Hex, def.Name)
+	return tea.SetClipboard(def.Hex)
+}
+
+// exportSelected writes the selected palette to a file named after it, and reports the result in the status line.
+func (m *Model) exportSelected() {
+	scheme, ok := m.Selected()
+	if !ok {
+		return
+	}
+
+	path, err := exportPalette(scheme, m.opts)
+	if err != nil {
+		m.status, m.err = err.Error(), true
+		return
+	}
+	m.status = fmt.Sprintf("Exported %s to %s", scheme.Name(), path)
+}
+
+// exportPalette writes a palette to a new file of the export directory, and returns the file path.
+// Existing files are never overwritten.
+func exportPalette(scheme registry.ColorScheme, opts Options) (string, error) {
+	p, ok := scheme.(*palette.Palette)
+	if !ok {
+		return "", fmt.Errorf("palette '%s' does not provide color definitions", scheme.Name())
+	}
+
+	path := filepath.Join(opts.Dir, fileName(p.Name())+opts.Format.Extension())
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644) //nolint:gosec // the file name is derived from the palette name
+	if errors.Is(err, fs.ErrExist) {
+		return "", fmt.Errorf("not exporting %s: %s already exists", p.Name(), path)
+	}
+	if err != nil {
+		return "", fmt.Errorf("exporting %s: %w", p.Name(), err)
+	}
+
+	// Remove a partly written file, which would make every retry fail
+	if err := export.Write(f, p, opts.Format); err != nil {
+		return "", errors.Join(fmt.Errorf("exporting %s: %w", p.Name(), err), f.Close(), os.Remove(path))
+	}
+	if err := f.Close(); err != nil {
+		return "", errors.Join(fmt.Errorf("exporting %s: %w", p.Name(), err), os.Remove(path))
+	}
+	return path, nil
+}
+
+// View implements the tea.Model interface.
+func (m Model) View() tea.View {
+	view := tea.NewView(m.render())
+	view.AltScreen = true
+	return view
+}
+
+// render returns the content of the browser screen.
+func (m Model) render() string {
+	if m.width == 0 || m.height == 0 {
+		return ""
+	}
+
+	var footer []string
+	if m.filter.Focused() || m.filter.Value() != "" {
+		footer = append(footer, m.filter.View())
+	}
+	if m.status != "" {
+		style := statusStyle
+		if m.err {
+			style = errorStyle
+		}
+		footer = append(footer, style.Render(m.status))
+	}
+	footer = append(footer, m.help.View(m.keys))
+	footerLines := strings.Split(strings.Join(footer, "\n"), "\n")
+
+	title := titleStyle.Render(fmt.Sprintf("Palettes (%d/%d)", len(m.visible), len(m.schemes)))
+	height := max(m.height-len(footerLines)-2, 1)
+
+	list := m.renderList(height)
+	preview := m.renderPreview(height, m.width-listWidth)
+
+	var b strings.Builder
+	b.WriteString(title + "\n\n")
+	for i := range height {
+		var left, right string
+		if i < len(list) {
+			left = list[i]
+		}
+		if i < len(preview) {
+			right = preview[i]
+		}
+		b.WriteString(pad(left, listWidth-2) + "│ " + right + "\n")
+	}
+	b.WriteString(strings.Join(footerLines, "\n"))
+	return b.String()
+}
+
+// renderList returns the lines of the palette list, scrolled to show the selected palette.
+func (m Model) renderList(height int) []string {
+	if len(m.visible) == 0 {
+		return []string{statusStyle.Render("No palette matches the filter")}
+	}
+
+	var lines []string
+	selectedLine := 0
+	for i, scheme := range m.visible {
+		if i == 0 || !strings.EqualFold(group(scheme), group(m.visible[i-1])) {
+			if i > 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, groupStyle.Render(strings.ToUpper(group(scheme))))
+		}
+
+		name := ansi.Truncate(scheme.Name(), listWidth-5, "…")
+		if i == m.cursor {
+			selectedLine = len(lines)
+			style := selectedStyle
+			if m.focus != focusList {
+				style = titleStyle
+			}
+			lines = append(lines, "▸ "+style.Render(name))
+			continue
+		}
+		lines = append(lines, "  "+name)
+	}
+	return scroll(lines, selectedLine, height)
+}
+
+// renderPreview returns the lines of the preview of the selected palette, scrolled to show the selected color.
+func (m Model) renderPreview(height, width int) []string {
+	scheme, ok := m.Selected()
+	if !ok || width <= 4 {
+		return nil
+	}
+
+	// Palettes are rendered to fit the pane with the selection marker and a margin (4 columns)
+	var rendered string
+	if p, ok := scheme.(*palette.Palette); ok {
+		rendered = p.RenderLayout(palette.LayoutText, width-4)
+	} else {
+		var buf bytes.Buffer
+		if _, err := scheme.WriteTo(&buf); err != nil {
+			return []string{errorStyle.Render(err.Error())}
+		}
+		rendered = buf.String()
+	}
+
+	// The first line is the title, followed by one line per color
+	lines := strings.Split(strings.TrimRight(rendered, "\n"), "\n")
+	for i, line := range lines {
+		marker := "  "
+		if m.focus == focusColors && i == m.color+1 {
+			marker = "▸ "
+		}
+		lines[i] = ansi.Truncate(marker+line, width-2, "…")
+	}
+	return scroll(lines, m.color+1, height)
+}
+
+// filterSchemes returns the schemes matching a filter: a family query, or text contained
+// in the name or a family of the schemes (ignoring case, accents and separators, see
+// [registry.NameKey]). An empty filter matches all schemes.
+func filterSchemes(schemes []registry.ColorScheme, filter string) ([]registry.ColorScheme, error) {
+	filter = strings.ToLower(strings.TrimSpace(filter))
+	if filter == "" {
+		return schemes, nil
+	}
+
+	if registry.IsQuery(filter) {
+		query, err := registry.ParseQuery(filter)
+		if err != nil {
+			return nil, err
+		}
+		return slices.DeleteFunc(slices.Clone(schemes), func(s registry.ColorScheme) bool {
+			return !query.Match(s)
+		}), nil
+	}
+
+	// Compare the names and families like the registry does, ignoring accents and separators
+	key := registry.NameKey(filter)
+	var matches []registry.ColorScheme
+	for _, scheme := range schemes {
+		if strings.Contains(registry.NameKey(scheme.Name()), key) ||
+			slices.ContainsFunc(scheme.Families(), func(f string) bool {
+				return strings.Contains(registry.NameKey(f), key)
+			}) {
+			matches = append(matches, scheme)
+		}
+	}
+	return matches, nil
+}
+
+// group returns the group of a scheme in the list: its first family.
+func group(scheme registry.ColorScheme) string {
+	if families := scheme.Families(); len(families) > 0 {
+		return families[0]
+	}
+	return "other"
+}
+
+// fileName converts a palette name to a file name: lowercase, with dashes instead of spaces and symbols.
+func fileName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return '-'
+	}, strings.TrimSpace(name))
+
+	for strings.Contains(name, "--") {
+		name = strings.ReplaceAll(name, "--", "-")
+	}
+	return strings.Trim(name, "-")
+}
+
+// scroll returns the window of at most height lines that contains the selected line,
+// keeping the selected line centered when possible.
+func scroll(lines []string, selected, height int) []string {
+	if len(lines) <= height {
+		return lines
+	}
+	start := clamp(selected-height/2, 0, len(lines)-height)
+	return lines[start : start+height]
+}
+
+// pad pads a styled string with spaces to the given width, truncating it if it is wider.
+func pad(s string, width int) string {
+	s = ansi.Truncate(s, width, "")
+	return s + strings.Repeat(" ", width-ansi.StringWidth(s))
+}
+
+// clamp restricts v to the range [low, high]. If the range is empty, it returns low.
+func clamp(v, low, high int) int {
+	return max(low, min(v, high))
+}
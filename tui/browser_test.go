@@ -0,0 +1,226 @@
+package tui_test
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/dr8co/palettes/export"
+	"github.com/dr8co/palettes/palette"
+	"github.com/dr8co/palettes/registry"
+	"github.com/dr8co/palettes/tui"
+)
+
+// newBrowser returns a browser of the bundled palettes, sized like a large terminal.
+func newBrowser(t *testing.T, opts tui.Options) tui.Model {
+	t.Helper()
+
+	reg := registry.NewSchemeRegistry()
+	if err := palette.RegisterAllSchemes(reg); err != nil {
+		t.Fatalf("RegisterAllSchemes: %v", err)
+	}
+	return update(t, tui.New(reg, opts), tea.WindowSizeMsg{Width: 160, Height: 50})
+}
+
+// update sends a message to the browser and returns the updated browser.
+func update(t *testing.T, m tui.Model, msg tea.Msg) tui.Model {
+	t.Helper()
+
+	next, _ := m.Update(msg)
+	model, ok := next.(tui.Model)
+	if !ok {
+		t.Fatalf("Update returned a %T, want a tui.Model", next)
+	}
+	return model
+}
+
+// press sends key presses to the browser: the named keys "down", "tab", "enter" and "esc",
+// or the characters of the other strings.
+func press(t *testing.T, m tui.Model, keys ...string) tui.Model {
+	t.Helper()
+
+	for _, k := range keys {
+		var msg tea.KeyPressMsg
+		switch k {
+		case "down":
+			msg = tea.KeyPressMsg{Code: tea.KeyDown}
+		case "tab":
+			msg = tea.KeyPressMsg{Code: tea.KeyTab}
+		case "enter":
+			msg = tea.KeyPressMsg{Code: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyPressMsg{Code: tea.KeyEscape}
+		default:
+			for _, r := range k {
+				m = update(t, m, tea.KeyPressMsg{Code: r, Text: string(r)})
+			}
+			continue
+		}
+		m = update(t, m, msg)
+	}
+	return m
+}
+
+// selected returns the name of the selected palette.
+func selected(t *testing.T, m tui.Model) string {
+	t.Helper()
+
+	scheme, ok := m.Selected()
+	if !ok {
+		t.Fatal("no palette is selected")
+	}
+	return scheme.Name()
+}
+
+func TestBrowserNavigation(t *testing.T) {
+	t.Parallel()
+
+	m := newBrowser(t, tui.Options{})
+	if got := selected(t, m); got != "Catppuccin frappe" {
+		t.Errorf("initial selection = %s, want Catppuccin frappe", got)
+	}
+
+	m = press(t, m, "down", "j")
+	if got := selected(t, m); got != "Catppuccin macchiato" {
+		t.Errorf("selection after two moves down = %s, want Catppuccin macchiato", got)
+	}
+
+	// Moving through the colors keeps the palette selected
+	m = press(t, m, "tab", "j", "j")
+	if got := selected(t, m); got != "Catppuccin macchiato" {
+		t.Errorf("selection after moving through the colors = %s, want Catppuccin macchiato", got)
+	}
+
+	m = press(t, m, "G", "tab", "G")
+	if got := selected(t, m); got != "Tokyo Night light" {
+		t.Errorf("selection after moving to the end = %s, want Tokyo Night light", got)
+	}
+
+	content := m.View().Content
+	for _, want := range []string{"Palettes (20/20)", "CATPPUCCIN", "TOKYO NIGHT", "Palette: "} {
+		if !strings.Contains(content, want) {
+			t.Errorf("view does not contain %q", want)
+		}
+	}
+}
+
+func TestBrowserFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		filter  string
+		visible string
+		first   string
+	}{
+		{filter: "frost", visible: "Palettes (1/20)", first: "Nord frost"},
+		{filter: "nord", visible: "Palettes (4/20)", first: "Nord aurora"},
+		{filter: "light & !catppuccin", visible: "Palettes (2/20)", first: "Gruvbox light"},
+		{filter: "rose-pine_moon", visible: "Palettes (1/20)", first: "Rosé Pine moon"},
+		{filter: "frappé", visible: "Palettes (1/20)", first: "Catppuccin frappe"},
+		{filter: "tokyonight", visible: "Palettes (2/20)", first: "Tokyo Night dark"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			t.Parallel()
+
+			m := press(t, newBrowser(t, tui.Options{}), "/", tt.filter)
+			if got := selected(t, m); got != tt.first {
+				t.Errorf("selection = %s, want %s", got, tt.first)
+			}
+			if content := m.View().Content; !strings.Contains(content, tt.visible) {
+				t.Errorf("view does not contain %q", tt.visible)
+			}
+
+			m = press(t, m, "esc")
+			if content := m.View().Content; !strings.Contains(content, "Palettes (20/20)") {
+				t.Error("clearing the filter does not show all the palettes")
+			}
+		})
+	}
+}
+
+func TestBrowserActions(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	m := press(t, newBrowser(t, tui.Options{Format: export.FormatKitty, Dir: dir}), "/", "dracula", "enter")
+
+	m = press(t, m, "e")
+	path := filepath.Join(dir, "dracula.conf")
+	data, err := os.ReadFile(path) //nolint:gosec // test file
+	if err != nil {
+		t.Fatalf("exported file: %v", err)
+	}
+	if !strings.Contains(string(data), "# Dracula") || !strings.Contains(string(data), "color15 #f8f8f2") {
+		t.Errorf("exported file is not a Kitty theme of Dracula:\n%s", data)
+	}
+	if content := m.View().Content; !strings.Contains(content, "Exported Dracula to "+path) {
+		t.Error("view does not report the export")
+	}
+
+	// Exporting again does not overwrite the file
+	if err := os.WriteFile(path, []byte("edited"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	m = press(t, m, "e")
+	if data, _ := os.ReadFile(path); string(data) != "edited" { //nolint:gosec // test file
+		t.Errorf("exporting again overwrote the file:\n%s", data)
+	}
+	if content := m.View().Content; !strings.Contains(content, path+" already exists") {
+		t.Error("view does not report the existing file")
+	}
+
+	next, cmd := press(t, m, "tab", "j").Update(tea.KeyPressMsg{Code: 'c', Text: "c"})
+	if cmd == nil {
+		t.Error("copying a color returned no command")
+	}
+	if content := next.View().Content; !strings.Contains(content, "Copied #44475a (current line)") {
+		t.Error("view does not report the copied color")
+	}
+}
+
+// TestBrowserExportFailure checks that a failed export leaves no file behind, so that it can be retried.
+func TestBrowserExportFailure(t *testing.T) {
+	t.Parallel()
+
+	reg := registry.NewSchemeRegistry()
+	reg.Register(palette.NewPalette("Empty", "dark"))
+	dir := t.TempDir()
+	m := update(t, tui.New(reg, tui.Options{Format: export.FormatKitty, Dir: dir}), tea.WindowSizeMsg{Width: 160, Height: 50})
+
+	for range 2 {
+		m = press(t, m, "e")
+		if content := m.View().Content; !strings.Contains(content, "palette Empty has no colors") {
+			t.Errorf("view does not report the export error:\n%s", content)
+		}
+		if _, err := os.Stat(filepath.Join(dir, "empty.conf")); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("failed export left a file behind (stat: %v)", err)
+		}
+	}
+}
+
+// TestBrowserNarrowPreview checks that the preview is rendered at the width of its pane,
+// instead of being cut.
+func TestBrowserNarrowPreview(t *testing.T) {
+	t.Parallel()
+
+	m := update(t, newBrowser(t, tui.Options{}), tea.WindowSizeMsg{Width: 80, Height: 30})
+	m = press(t, m, "/", "dracula", "enter")
+
+	content := m.View().Content
+	for _, def := range palette.CreateDraculaPalette().Definitions() {
+		if !strings.Contains(content, def.Hex) {
+			t.Errorf("preview does not show %s (%s):\n%s", def.Name, def.Hex, content)
+		}
+	}
+	for i, line := range strings.Split(content, "\n") {
+		if strings.Contains(line, "…") {
+			t.Errorf("line %d is cut: %q", i+1, line)
+		}
+	}
+}
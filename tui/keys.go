@@ -0,0 +1,83 @@
+package tui
+
+import "charm.land/bubbles/v2/key"
+
+// keyMap holds the key bindings of the browser.
+type keyMap struct {
+	Up     key.Binding
+	Down   key.Binding
+	Top    key.Binding
+	Bottom key.Binding
+	Switch key.Binding
+	Filter key.Binding
+	Clear  key.Binding
+	Copy   key.Binding
+	Export key.Binding
+	Help   key.Binding
+	Quit   key.Binding
+}
+
+// defaultKeyMap returns the default key bindings of the browser.
+func defaultKeyMap() keyMap {
+	return keyMap{
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "down"),
+		),
+		Top: key.NewBinding(
+			key.WithKeys("home", "g"),
+			key.WithHelp("g/home", "first"),
+		),
+		Bottom: key.NewBinding(
+			key.WithKeys("end", "G"),
+			key.WithHelp("G/end", "last"),
+		),
+		Switch: key.NewBinding(
+			key.WithKeys("tab", "left", "right", "h", "l"),
+			key.WithHelp("tab/←/→", "palettes/colors"),
+		),
+		Filter: key.NewBinding(
+			key.WithKeys("/"),
+			key.WithHelp("/", "filter"),
+		),
+		Clear: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "clear filter"),
+		),
+		Copy: key.NewBinding(
+			key.WithKeys("c", "y"),
+			key.WithHelp("c/y", "copy hex"),
+		),
+		Export: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "export"),
+		),
+		Help: key.NewBinding(
+			key.WithKeys("?"),
+			key.WithHelp("?", "more"),
+		),
+		Quit: key.NewBinding(
+			key.WithKeys("q", "ctrl+c"),
+			key.WithHelp("q", "quit"),
+		),
+	}
+}
+
+// ShortHelp implements the help.KeyMap interface.
+func (k keyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Switch, k.Filter, k.Copy, k.Export, k.Help, k.Quit}
+}
+
+// FullHelp implements the help.KeyMap interface.
+func (k keyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Top, k.Bottom},
+		{k.Switch, k.Filter, k.Clear},
+		{k.Copy, k.Export},
+		{k.Help, k.Quit},
+	}
+}